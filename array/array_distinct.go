@@ -0,0 +1,66 @@
+package array
+
+// Uniq creates a new array with the first occurrence of each element of the
+// input array, in their original order.
+func Uniq[T comparable](in []T) []T {
+	seen := make(map[T]struct{}, len(in))
+	out := make([]T, 0, len(in))
+	for _, val := range in {
+		if _, found := seen[val]; !found {
+			seen[val] = struct{}{}
+			out = append(out, val)
+		}
+	}
+	return out
+}
+
+// UniqBy creates a new array with the first element of the input array
+// producing each key computed by the `key` function, in their original order.
+func UniqBy[T any, K comparable](in []T, key func(T) K) []T {
+	seen := make(map[K]struct{}, len(in))
+	out := make([]T, 0, len(in))
+	for _, val := range in {
+		k := key(val)
+		if _, found := seen[k]; !found {
+			seen[k] = struct{}{}
+			out = append(out, val)
+		}
+	}
+	return out
+}
+
+// Intersect creates a new array with the distinct elements of the array `a`
+// which are also present in the array `b`, in the order they appear in `a`.
+func Intersect[T comparable](a, b []T) []T {
+	others := toSet(b)
+	out := make([]T, 0)
+	for _, val := range a {
+		if _, found := others[val]; found {
+			out = append(out, val)
+			// Prevents duplicates in the result.
+			delete(others, val)
+		}
+	}
+	return out
+}
+
+// Difference creates a new array with the elements of the array `a` which are
+// not present in the array `b`, in the order they appear in `a`.
+func Difference[T comparable](a, b []T) []T {
+	others := toSet(b)
+	out := make([]T, 0)
+	for _, val := range a {
+		if _, found := others[val]; !found {
+			out = append(out, val)
+		}
+	}
+	return out
+}
+
+func toSet[T comparable](in []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(in))
+	for _, val := range in {
+		set[val] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,58 @@
+package array
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Uniq(t *testing.T) {
+	in := []int{3, 1, 3, 2, 1}
+
+	assert.Equal(t, []int{3, 1, 2}, Uniq(in))
+	assert.Equal(t, []int{}, Uniq([]int{}), "empty")
+}
+
+func Test_UniqBy(t *testing.T) {
+	in := []string{"Go", "go", "Rust", "GO", "rust"}
+
+	assert.Equal(t, []string{"Go", "Rust"}, UniqBy(in, strings.ToLower))
+}
+
+func Test_Intersect(t *testing.T) {
+	a := []int{1, 2, 2, 3, 4}
+	b := []int{4, 2, 5}
+
+	assert.Equal(t, []int{2, 4}, Intersect(a, b))
+	assert.Equal(t, []int{}, Intersect(a, nil), "empty")
+}
+
+func Test_Difference(t *testing.T) {
+	a := []int{1, 2, 2, 3, 1}
+	b := []int{2, 5}
+
+	assert.Equal(t, []int{1, 3, 1}, Difference(a, b))
+	assert.Equal(t, a, Difference(a, nil), "empty")
+}
+
+func Benchmark_Uniq(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Uniq(benchmarkData)
+	}
+}
+
+func Benchmark_Uniq_loop(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		seen := make(map[int]bool)
+		var out []int
+		for _, v := range benchmarkData {
+			if !seen[v] {
+				seen[v] = true
+				out = append(out, v)
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package array
+
+// GroupBy groups the elements of the input array by the key computed by the
+// `key` function. The elements of each group keep their relative order.
+func GroupBy[T any, K comparable](in []T, key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, val := range in {
+		k := key(val)
+		groups[k] = append(groups[k], val)
+	}
+	return groups
+}
+
+// KeyBy creates a map indexing the elements of the input array by the key
+// computed by the `key` function. When several elements produce the same key,
+// the last one is kept.
+func KeyBy[T any, K comparable](in []T, key func(T) K) map[K]T {
+	out := make(map[K]T, len(in))
+	for _, val := range in {
+		out[key(val)] = val
+	}
+	return out
+}
+
+// Partition splits the input array into the elements which pass the provided
+// `condition` function and the elements which do not, both keeping their
+// relative order.
+//
+// Both arrays share a single allocation.
+func Partition[T any](in []T, condition func(T) bool) ([]T, []T) {
+	out := make([]T, len(in))
+	matched, rest := 0, len(in)
+	for _, val := range in {
+		if condition(val) {
+			out[matched] = val
+			matched++
+		} else {
+			rest--
+			out[rest] = val
+		}
+	}
+	reverse(out[matched:])
+	return out[:matched:matched], out[matched:]
+}
+
+// Chunk splits the input array into arrays of `size` elements. The last array
+// may be shorter if the length of the input array is not a multiple of `size`.
+//
+// The chunks are slices of the input array, so they share its memory.
+//
+// Chunk panics if `size` is not positive.
+func Chunk[T any](in []T, size int) [][]T {
+	if size <= 0 {
+		panic("chunk size must be positive")
+	}
+	out := make([][]T, 0, (len(in)+size-1)/size)
+	for start := 0; start < len(in); start += size {
+		end := start + size
+		if end > len(in) {
+			end = len(in)
+		}
+		out = append(out, in[start:end:end])
+	}
+	return out
+}
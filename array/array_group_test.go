@@ -0,0 +1,114 @@
+package array
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GroupBy(t *testing.T) {
+	in := []string{"one", "two", "three", "four", "five"}
+	actual := GroupBy(in, func(s string) int {
+		return len(s)
+	})
+	expected := map[int][]string{
+		3: {"one", "two"},
+		4: {"four", "five"},
+		5: {"three"},
+	}
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_KeyBy(t *testing.T) {
+	in := []string{"apple", "avocado", "banana"}
+	actual := KeyBy(in, func(s string) byte {
+		return s[0]
+	})
+
+	assert.Equal(t, map[byte]string{'a': "avocado", 'b': "banana"}, actual)
+}
+
+func Test_Partition(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6, 7}
+	even, odd := Partition(in, func(i int) bool {
+		return i%2 == 0
+	})
+
+	assert.Equal(t, []int{2, 4, 6}, even)
+	assert.Equal(t, []int{1, 3, 5, 7}, odd)
+
+	even = append(even, 8)
+	assert.Equal(t, []int{1, 3, 5, 7}, odd, "independent")
+}
+
+func Test_Partition_empty(t *testing.T) {
+	matched, rest := Partition([]int{}, func(i int) bool {
+		return true
+	})
+
+	assert.Empty(t, matched)
+	assert.Empty(t, rest)
+}
+
+func Test_Chunk(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5}
+	actual := Chunk(in, 2)
+
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, actual)
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, Chunk(in, 10), "larger")
+	assert.Empty(t, Chunk([]int{}, 2), "empty")
+	assert.Panics(t, func() { Chunk(in, 0) }, "invalid")
+}
+
+func Test_Chunk_capacity(t *testing.T) {
+	in := []int{1, 2, 3, 4}
+	chunks := Chunk(in, 2)
+	chunks[0] = append(chunks[0], 9)
+
+	assert.Equal(t, []int{1, 2, 3, 4}, in, "append does not overwrite")
+}
+
+func Benchmark_Partition(b *testing.B) {
+	even := func(v int) bool {
+		return v%2 == 0
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Partition(benchmarkData, even)
+	}
+}
+
+func Benchmark_Partition_loop(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var matched, rest []int
+		for _, v := range benchmarkData {
+			if v%2 == 0 {
+				matched = append(matched, v)
+			} else {
+				rest = append(rest, v)
+			}
+		}
+	}
+}
+
+func Benchmark_GroupBy(b *testing.B) {
+	key := func(v int) int {
+		return v % 10
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		GroupBy(benchmarkData, key)
+	}
+}
+
+func Benchmark_GroupBy_loop(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		groups := make(map[int][]int)
+		for _, v := range benchmarkData {
+			groups[v%10] = append(groups[v%10], v)
+		}
+	}
+}
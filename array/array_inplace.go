@@ -0,0 +1,57 @@
+package array
+
+// FilterInPlace moves the elements of the array which pass the provided
+// `condition` function to its beginning, keeping their relative order, and
+// returns the slice of the array holding them.
+//
+// The elements left past the end of the returned slice are set to their zero
+// value, so they do not hold references the garbage collector could reclaim.
+func FilterInPlace[T any](arr []T, condition func(T) bool) []T {
+	kept := 0
+	for _, val := range arr {
+		if condition(val) {
+			arr[kept] = val
+			kept++
+		}
+	}
+	var zero T
+	for i := kept; i < len(arr); i++ {
+		arr[i] = zero
+	}
+	return arr[:kept]
+}
+
+// MapInto writes into the array `dst` the result of calling the provided
+// `mapper` function on every element of the array `src`, and returns the
+// slice of `dst` holding them.
+//
+// The array `dst` is reused if its capacity is large enough, otherwise a new
+// array is allocated.
+func MapInto[I any, O any](dst []O, src []I, mapper func(I) O) []O {
+	if cap(dst) < len(src) {
+		dst = make([]O, len(src))
+	}
+	dst = dst[:len(src)]
+	for i, val := range src {
+		dst[i] = mapper(val)
+	}
+	return dst
+}
+
+// Retain removes in place from the array the elements which do not pass the
+// provided `condition` function, as FilterInPlace, and returns the number of
+// elements removed.
+func Retain[T any](arr *[]T, condition func(T) bool) int {
+	size := len(*arr)
+	*arr = FilterInPlace(*arr, condition)
+	return size - len(*arr)
+}
+
+// RemoveIf removes in place from the array the elements which pass the
+// provided `condition` function, as FilterInPlace, and returns the number of
+// elements removed.
+func RemoveIf[T any](arr *[]T, condition func(T) bool) int {
+	return Retain(arr, func(val T) bool {
+		return !condition(val)
+	})
+}
@@ -0,0 +1,146 @@
+package array
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FilterInPlace(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6}
+	actual := FilterInPlace(in, func(i int) bool {
+		return i%2 == 0
+	})
+
+	assert.Equal(t, []int{2, 4, 6}, actual)
+	assert.Equal(t, []int{2, 4, 6, 0, 0, 0}, in, "zeroed tail")
+	assert.Same(t, &in[0], &actual[0], "same array")
+}
+
+func Test_FilterInPlace_pointers(t *testing.T) {
+	a, b := "a", "b"
+	in := []*string{&a, &b}
+	FilterInPlace(in, func(s *string) bool {
+		return *s == "a"
+	})
+
+	assert.Nil(t, in[1], "released")
+}
+
+func Test_FilterInPlace_empty(t *testing.T) {
+	assert.Empty(t, FilterInPlace([]int{}, func(int) bool { return true }))
+}
+
+func Test_MapInto(t *testing.T) {
+	buf := make([]string, 0, 8)
+	actual := MapInto(buf, []int{1, 2, 3}, strconv.Itoa)
+
+	assert.Equal(t, []string{"1", "2", "3"}, actual)
+	assert.Same(t, &buf[:1][0], &actual[0], "reused")
+}
+
+func Test_MapInto_grow(t *testing.T) {
+	buf := make([]string, 1)
+	actual := MapInto(buf, []int{1, 2}, strconv.Itoa)
+
+	assert.Equal(t, []string{"1", "2"}, actual)
+	assert.Equal(t, []string{""}, buf, "untouched")
+}
+
+func Test_Retain(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+	removed := Retain(&arr, func(i int) bool {
+		return i > 2
+	})
+
+	assert.Equal(t, 2, removed)
+	assert.Equal(t, []int{3, 4, 5}, arr)
+}
+
+func Test_RemoveIf(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+	removed := RemoveIf(&arr, func(i int) bool {
+		return i%2 == 1
+	})
+
+	assert.Equal(t, 3, removed)
+	assert.Equal(t, []int{2, 4}, arr)
+}
+
+func Test_inplace_allocations(t *testing.T) {
+	arr := make([]int, len(benchmarkData))
+	buf := make([]int, 0, len(benchmarkData))
+	even := func(v int) bool {
+		return v%2 == 0
+	}
+	double := func(v int) int {
+		return v * 2
+	}
+	allocs := testing.AllocsPerRun(10, func() {
+		copy(arr, benchmarkData)
+		FilterInPlace(arr, even)
+		MapInto(buf, benchmarkData, double)
+		s := arr
+		Retain(&s, even)
+		RemoveIf(&s, even)
+	})
+
+	assert.Zero(t, allocs)
+}
+
+func Benchmark_FilterInPlace(b *testing.B) {
+	arr := make([]int, len(benchmarkData))
+	even := func(v int) bool {
+		return v%2 == 0
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copy(arr, benchmarkData)
+		FilterInPlace(arr, even)
+	}
+}
+
+func Benchmark_Filter(b *testing.B) {
+	even := func(v int) bool {
+		return v%2 == 0
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Filter(benchmarkData, even)
+	}
+}
+
+func Benchmark_MapInto(b *testing.B) {
+	buf := make([]int, len(benchmarkData))
+	double := func(v int) int {
+		return v * 2
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		MapInto(buf, benchmarkData, double)
+	}
+}
+
+func Benchmark_Map(b *testing.B) {
+	double := func(v int) int {
+		return v * 2
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Map(benchmarkData, double)
+	}
+}
+
+func Benchmark_RemoveIf(b *testing.B) {
+	arr := make([]int, len(benchmarkData))
+	odd := func(v int) bool {
+		return v%2 == 1
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copy(arr, benchmarkData)
+		s := arr
+		RemoveIf(&s, odd)
+	}
+}
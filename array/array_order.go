@@ -0,0 +1,96 @@
+package array
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// Ordered is the set of types supporting the `<` operator.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// keySorter sorts an array by precomputed keys, so the `key` function is
+// called once per element.
+type keySorter[T any, K Ordered] struct {
+	items []T
+	keys  []K
+}
+
+func (s keySorter[T, K]) Len() int {
+	return len(s.items)
+}
+
+func (s keySorter[T, K]) Less(i, j int) bool {
+	return s.keys[i] < s.keys[j]
+}
+
+func (s keySorter[T, K]) Swap(i, j int) {
+	s.items[i], s.items[j] = s.items[j], s.items[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+func newKeySorter[T any, K Ordered](in []T, key func(T) K) keySorter[T, K] {
+	s := keySorter[T, K]{
+		items: make([]T, len(in)),
+		keys:  make([]K, len(in)),
+	}
+	copy(s.items, in)
+	for i, val := range in {
+		s.keys[i] = key(val)
+	}
+	return s
+}
+
+// SortBy creates a new array with the elements of the input array sorted in
+// ascending order of the key computed by the `key` function. The order of
+// elements with equal keys is unspecified.
+func SortBy[T any, K Ordered](in []T, key func(T) K) []T {
+	s := newKeySorter(in, key)
+	sort.Sort(s)
+	return s.items
+}
+
+// SortStableBy creates a new array with the elements of the input array
+// sorted in ascending order of the key computed by the `key` function.
+// Elements with equal keys keep their relative order.
+func SortStableBy[T any, K Ordered](in []T, key func(T) K) []T {
+	s := newKeySorter(in, key)
+	sort.Stable(s)
+	return s.items
+}
+
+// Reverse creates a new array with the elements of the input array in reverse
+// order.
+func Reverse[T any](in []T) []T {
+	out := make([]T, len(in))
+	for i, val := range in {
+		out[len(in)-1-i] = val
+	}
+	return out
+}
+
+func reverse[T any](arr []T) {
+	for i, j := 0, len(arr)-1; i < j; i, j = i+1, j-1 {
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+}
+
+// Shuffle creates a new array with the elements of the input array in a
+// random order, drawn from the `random` source. If `random` is nil, the
+// default source of the math/rand package is used.
+func Shuffle[T any](in []T, random *rand.Rand) []T {
+	out := make([]T, len(in))
+	copy(out, in)
+	swap := func(i, j int) {
+		out[i], out[j] = out[j], out[i]
+	}
+	if random == nil {
+		rand.Shuffle(len(out), swap)
+	} else {
+		random.Shuffle(len(out), swap)
+	}
+	return out
+}
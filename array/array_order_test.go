@@ -0,0 +1,85 @@
+package array
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type orderTestItem struct {
+	name string
+	rank int
+}
+
+func Test_SortBy(t *testing.T) {
+	in := []string{"ccc", "a", "bb"}
+	actual := SortBy(in, func(s string) int {
+		return len(s)
+	})
+
+	assert.Equal(t, []string{"a", "bb", "ccc"}, actual)
+	assert.Equal(t, []string{"ccc", "a", "bb"}, in, "unchanged")
+}
+
+func Test_SortStableBy(t *testing.T) {
+	in := []orderTestItem{{"d", 2}, {"a", 1}, {"c", 2}, {"b", 1}, {"e", 0}}
+	actual := SortStableBy(in, func(i orderTestItem) int {
+		return i.rank
+	})
+	expected := []orderTestItem{{"e", 0}, {"a", 1}, {"b", 1}, {"d", 2}, {"c", 2}}
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_SortBy_key_calls(t *testing.T) {
+	calls := 0
+	SortBy(benchmarkData, func(v int) int {
+		calls++
+		return v
+	})
+
+	assert.Equal(t, len(benchmarkData), calls)
+}
+
+func Test_Reverse(t *testing.T) {
+	in := []int{1, 2, 3}
+
+	assert.Equal(t, []int{3, 2, 1}, Reverse(in))
+	assert.Equal(t, []int{1, 2, 3}, in, "unchanged")
+	assert.Equal(t, []int{}, Reverse([]int{}), "empty")
+}
+
+func Test_Shuffle(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	a := Shuffle(in, rand.New(rand.NewSource(42)))
+	b := Shuffle(in, rand.New(rand.NewSource(42)))
+
+	assert.Equal(t, a, b, "deterministic")
+	assert.NotEqual(t, in, a, "shuffled")
+	assert.ElementsMatch(t, in, a, "elements")
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, in, "unchanged")
+	assert.ElementsMatch(t, in, Shuffle(in, nil), "default source")
+}
+
+func Benchmark_SortBy(b *testing.B) {
+	key := func(v int) int {
+		return -v
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		SortBy(benchmarkData, key)
+	}
+}
+
+func Benchmark_SortBy_loop(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		out := make([]int, len(benchmarkData))
+		copy(out, benchmarkData)
+		sort.Slice(out, func(i, j int) bool {
+			return -out[i] < -out[j]
+		})
+	}
+}
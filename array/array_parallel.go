@@ -0,0 +1,143 @@
+package array
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/mikhasd/fluent"
+)
+
+// cancellationCheck is the number of elements a worker processes between two
+// checks of the context cancellation.
+const cancellationCheck = 256
+
+// chunks returns the size and the number of the contiguous chunks `size`
+// elements are split into for at most `workers` workers. If `workers` is not
+// positive, a worker per CPU is used.
+func chunks(size, workers int) (int, int) {
+	if size == 0 {
+		return 0, 0
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	chunkSize := (size + workers - 1) / workers
+	return chunkSize, (size + chunkSize - 1) / chunkSize
+}
+
+// parallel splits `size` elements into chunks, as computed by the chunks
+// function, and calls `process` for each chunk from its own goroutine,
+// passing the index of the chunk and its bounds. Workers must stop early when
+// `cancelled` returns true for the position in the chunk of the element they
+// are about to process.
+//
+// parallel returns the error of the context, if it is done once every worker
+// returned.
+func parallel(ctx context.Context, size, workers int, process func(chunk, start, end int, cancelled func(int) bool)) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	chunkSize, count := chunks(size, workers)
+	cancelled := func(i int) bool {
+		return i%cancellationCheck == 0 && ctx.Err() != nil
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(count)
+	for c := 0; c < count; c++ {
+		start := c * chunkSize
+		end := start + chunkSize
+		if end > size {
+			end = size
+		}
+		go func(c, start, end int) {
+			defer wg.Done()
+			process(c, start, end, cancelled)
+		}(c, start, end)
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+// ParallelMap creates a new array populated with the result of calling the
+// provided `mapper` function on every element of the input array, splitting
+// the input array into a chunk per worker. If `workers` is not positive, a
+// worker per CPU is used.
+//
+// If the context is done before every element is mapped, ParallelMap stops
+// and returns an Err Result with the context error.
+func ParallelMap[I any, O any](ctx context.Context, in []I, workers int, mapper func(I) O) fluent.Result[[]O] {
+	out := make([]O, len(in))
+	err := parallel(ctx, len(in), workers, func(_, start, end int, cancelled func(int) bool) {
+		for i := start; i < end; i++ {
+			if cancelled(i - start) {
+				return
+			}
+			out[i] = mapper(in[i])
+		}
+	})
+	if err != nil {
+		return fluent.Err[[]O](err)
+	}
+	return fluent.Ok(out)
+}
+
+// ParallelFilter creates a new array with all elements that pass the provided
+// `condition` function, in their original order, splitting the input array
+// into a chunk per worker. If `workers` is not positive, a worker per CPU is
+// used.
+//
+// If the context is done before every element is tested, ParallelFilter stops
+// and returns an Err Result with the context error.
+func ParallelFilter[T any](ctx context.Context, in []T, workers int, condition func(T) bool) fluent.Result[[]T] {
+	_, count := chunks(len(in), workers)
+	results := make([][]T, count)
+	err := parallel(ctx, len(in), workers, func(chunk, start, end int, cancelled func(int) bool) {
+		var matched []T
+		for i := start; i < end; i++ {
+			if cancelled(i - start) {
+				return
+			}
+			if condition(in[i]) {
+				matched = append(matched, in[i])
+			}
+		}
+		results[chunk] = matched
+	})
+	if err != nil {
+		return fluent.Err[[]T](err)
+	}
+	return fluent.Ok(Flatten(results))
+}
+
+// ParallelReduce combines the elements of the input array with the
+// `combine` function, splitting the input array into a chunk per worker. Each
+// chunk is reduced starting from `identity`, then the results of the chunks
+// are combined in order. If `workers` is not positive, a worker per CPU is
+// used.
+//
+// The `combine` function must be associative and `identity` must leave any
+// element unchanged when combined with it, so the result does not depend on
+// how the input array is split.
+//
+// If the context is done before every element is combined, ParallelReduce
+// stops and returns an Err Result with the context error.
+func ParallelReduce[T any](ctx context.Context, in []T, workers int, identity T, combine func(T, T) T) fluent.Result[T] {
+	_, count := chunks(len(in), workers)
+	partials := make([]T, count)
+	err := parallel(ctx, len(in), workers, func(chunk, start, end int, cancelled func(int) bool) {
+		acc := identity
+		for i := start; i < end; i++ {
+			if cancelled(i - start) {
+				return
+			}
+			acc = combine(acc, in[i])
+		}
+		partials[chunk] = acc
+	})
+	if err != nil {
+		return fluent.Err[T](err)
+	}
+	return fluent.Ok(Reduce(partials, identity, combine))
+}
@@ -0,0 +1,146 @@
+package array
+
+import (
+	"context"
+	"math"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParallelMap(t *testing.T) {
+	for _, workers := range []int{-1, 1, 3, 100000} {
+		r := ParallelMap(context.Background(), benchmarkData, workers, func(v int) int {
+			return v * 2
+		})
+
+		assert.True(t, r.IsOk(), "workers %d", workers)
+		assert.Equal(t, Map(benchmarkData, func(v int) int { return v * 2 }), r.Get(), "workers %d", workers)
+	}
+}
+
+func Test_ParallelMap_empty(t *testing.T) {
+	r := ParallelMap(context.Background(), []int{}, 4, func(v int) string {
+		return ""
+	})
+
+	assert.True(t, r.IsOk(), "ok")
+	assert.Empty(t, r.Get())
+}
+
+func Test_ParallelMap_workers(t *testing.T) {
+	var running, peak int32
+	ParallelMap(context.Background(), benchmarkData, 3, func(v int) int {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		atomic.AddInt32(&running, -1)
+		return v
+	})
+
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(3))
+}
+
+func Test_ParallelMap_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := ParallelMap(ctx, benchmarkData, 4, func(v int) int {
+		return v
+	})
+
+	assert.True(t, r.IsErr(), "err")
+	assert.Equal(t, context.Canceled, r.GetErr())
+}
+
+func Test_ParallelMap_cancel_midway(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var calls int32
+	r := ParallelMap(ctx, make([]int, 100000), 2, func(v int) int {
+		if atomic.AddInt32(&calls, 1) == 10 {
+			cancel()
+		}
+		return v
+	})
+
+	assert.True(t, r.IsErr(), "err")
+	assert.Less(t, int(atomic.LoadInt32(&calls)), 100000, "stopped early")
+}
+
+func Test_ParallelFilter(t *testing.T) {
+	even := func(v int) bool {
+		return v%2 == 0
+	}
+	for _, workers := range []int{0, 1, 7} {
+		r := ParallelFilter(context.Background(), benchmarkData, workers, even)
+
+		assert.True(t, r.IsOk(), "workers %d", workers)
+		assert.Equal(t, Filter(benchmarkData, even), r.Get(), "order, workers %d", workers)
+	}
+}
+
+func Test_ParallelFilter_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := ParallelFilter(ctx, benchmarkData, 2, func(v int) bool {
+		return true
+	})
+
+	assert.True(t, r.IsErr())
+}
+
+func Test_ParallelReduce(t *testing.T) {
+	sum := func(a, b int) int {
+		return a + b
+	}
+	expected := Reduce(benchmarkData, 0, sum)
+	for _, workers := range []int{0, 1, 5, 100000} {
+		r := ParallelReduce(context.Background(), benchmarkData, workers, 0, sum)
+
+		assert.True(t, r.IsOk(), "workers %d", workers)
+		assert.Equal(t, expected, r.Get(), "workers %d", workers)
+	}
+}
+
+func Test_ParallelReduce_order(t *testing.T) {
+	in := []string{"a", "b", "c", "d", "e"}
+	r := ParallelReduce(context.Background(), in, 2, "", func(a, b string) string {
+		return a + b
+	})
+
+	assert.Equal(t, "abcde", r.Get(), "non commutative")
+}
+
+func Test_ParallelReduce_empty(t *testing.T) {
+	r := ParallelReduce(context.Background(), []int{}, 2, 1, func(a, b int) int {
+		return a * b
+	})
+
+	assert.Equal(t, 1, r.Get())
+}
+
+func heavy(v int) float64 {
+	x := float64(v)
+	for i := 0; i < 100; i++ {
+		x = math.Sqrt(x*x + float64(i))
+	}
+	return x
+}
+
+func Benchmark_ParallelMap(b *testing.B) {
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		ParallelMap(ctx, benchmarkData, 0, heavy)
+	}
+}
+
+func Benchmark_ParallelMap_sequential(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Map(benchmarkData, heavy)
+	}
+}
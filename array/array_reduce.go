@@ -0,0 +1,55 @@
+package array
+
+// Reduce calls the `reducer` function on every element of the input array,
+// passing the result of the previous call, starting with `initial`, and
+// returns the result of the last call.
+func Reduce[T any, R any](in []T, initial R, reducer func(R, T) R) R {
+	acc := initial
+	for _, val := range in {
+		acc = reducer(acc, val)
+	}
+	return acc
+}
+
+// FlatMap creates a new array with the concatenation of the arrays returned by
+// calling the `mapper` function on every element of the input array.
+func FlatMap[I any, O any](in []I, mapper func(I) []O) []O {
+	mapped := make([][]O, len(in))
+	for i, val := range in {
+		mapped[i] = mapper(val)
+	}
+	return Flatten(mapped)
+}
+
+// Flatten creates a new array with the concatenation of the input arrays.
+func Flatten[T any](in [][]T) []T {
+	size := 0
+	for _, arr := range in {
+		size += len(arr)
+	}
+	out := make([]T, 0, size)
+	for _, arr := range in {
+		out = append(out, arr...)
+	}
+	return out
+}
+
+// Pair holds two values of possibly different types.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip creates a new array pairing the elements of both input arrays at the
+// same index. The result is as long as the shorter input array.
+func Zip[A any, B any](a []A, b []B) []Pair[A, B] {
+	size := len(a)
+	if len(b) < size {
+		size = len(b)
+	}
+	out := make([]Pair[A, B], size)
+	for i := range out {
+		out[i] = Pair[A, B]{a[i], b[i]}
+	}
+	return out
+}
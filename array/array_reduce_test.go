@@ -0,0 +1,90 @@
+package array
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var benchmarkData = func() []int {
+	data := make([]int, 10000)
+	for i := range data {
+		data[i] = (i * 7919) % 5000
+	}
+	return data
+}()
+
+func Test_Reduce(t *testing.T) {
+	in := []int{1, 2, 3, 4}
+	actual := Reduce(in, "", func(acc string, i int) string {
+		return acc + strconv.Itoa(i)
+	})
+
+	assert.Equal(t, "1234", actual)
+	assert.Equal(t, 7, Reduce([]int{}, 7, func(acc, i int) int { return acc + i }), "empty")
+}
+
+func Test_FlatMap(t *testing.T) {
+	in := []int{1, 2, 3}
+	actual := FlatMap(in, func(i int) []string {
+		out := make([]string, i)
+		for j := range out {
+			out[j] = strconv.Itoa(i)
+		}
+		return out
+	})
+
+	assert.Equal(t, []string{"1", "2", "2", "3", "3", "3"}, actual)
+}
+
+func Test_Flatten(t *testing.T) {
+	in := [][]int{{1, 2}, nil, {3}, {}}
+
+	assert.Equal(t, []int{1, 2, 3}, Flatten(in))
+	assert.Equal(t, []int{}, Flatten[int](nil), "empty")
+}
+
+func Test_Zip(t *testing.T) {
+	actual := Zip([]int{1, 2, 3}, []string{"a", "b"})
+	expected := []Pair[int, string]{{1, "a"}, {2, "b"}}
+
+	assert.Equal(t, expected, actual)
+}
+
+func Benchmark_Reduce(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Reduce(benchmarkData, 0, func(acc, v int) int {
+			return acc + v
+		})
+	}
+}
+
+func Benchmark_Reduce_loop(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		for _, v := range benchmarkData {
+			sum += v
+		}
+		_ = sum
+	}
+}
+
+func Benchmark_Flatten(b *testing.B) {
+	chunks := Chunk(benchmarkData, 10)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Flatten(chunks)
+	}
+}
+
+func Benchmark_Flatten_loop(b *testing.B) {
+	chunks := Chunk(benchmarkData, 10)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var out []int
+		for _, chunk := range chunks {
+			out = append(out, chunk...)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package array
+
+import "github.com/mikhasd/fluent"
+
+// Find returns the first element of the input array which passes the provided
+// `condition` function, or an empty Option if there is none.
+func Find[T any](in []T, condition func(T) bool) fluent.Option[T] {
+	for _, val := range in {
+		if condition(val) {
+			return fluent.Present(val)
+		}
+	}
+	return fluent.Empty[T]()
+}
+
+// IndexOf returns the index of the first occurrence of `element` in the input
+// array, or -1 if it is not present.
+func IndexOf[T comparable](in []T, element T) int {
+	for i, val := range in {
+		if val == element {
+			return i
+		}
+	}
+	return -1
+}
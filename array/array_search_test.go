@@ -0,0 +1,47 @@
+package array
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Find(t *testing.T) {
+	in := []int{1, 4, 6, 7}
+	even := func(i int) bool {
+		return i%2 == 0
+	}
+
+	o := Find(in, even)
+	assert.True(t, o.IsPresent(), "present")
+	assert.Equal(t, 4, o.Get())
+
+	o = Find([]int{1, 3}, even)
+	assert.False(t, o.IsPresent(), "empty")
+}
+
+func Test_IndexOf(t *testing.T) {
+	in := []string{"a", "b", "c", "b"}
+
+	assert.Equal(t, 1, IndexOf(in, "b"))
+	assert.Equal(t, -1, IndexOf(in, "z"))
+	assert.Equal(t, -1, IndexOf(nil, "a"), "empty")
+}
+
+func Benchmark_Find(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Find(benchmarkData, func(v int) bool {
+			return v == 4999
+		})
+	}
+}
+
+func Benchmark_Find_loop(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, v := range benchmarkData {
+			if v == 4999 {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package array
+
+import (
+	"sort"
+
+	"github.com/mikhasd/fluent"
+)
+
+// BinarySearchBy searches the `target` in the array, sorted in ascending order
+// according to the `compare` function, which must return a negative number if
+// `a` is lower than `b`, zero if they are equal and a positive number if `a` is
+// greater than `b`.
+//
+// BinarySearchBy returns the index of the first element equal to `target`, if
+// any, and the insertion point: the index where `target` should be inserted to
+// keep the array sorted, which is the index of the first element equal to or
+// greater than `target`.
+func BinarySearchBy[T any](arr []T, target T, compare func(a, b T) int) (fluent.Option[int], int) {
+	i := sort.Search(len(arr), func(i int) bool {
+		return compare(arr[i], target) >= 0
+	})
+	if i < len(arr) && compare(arr[i], target) == 0 {
+		return fluent.Present(i), i
+	}
+	return fluent.Empty[int](), i
+}
+
+// InsertSorted inserts the `element` into the array, sorted in ascending order
+// according to the `compare` function, keeping it sorted, and returns the
+// resulting array. The element is inserted before the elements equal to it.
+//
+// As with `append`, the array is reused if its capacity is large enough.
+func InsertSorted[T any](arr []T, element T, compare func(a, b T) int) []T {
+	_, i := BinarySearchBy(arr, element, compare)
+	var zero T
+	arr = append(arr, zero)
+	copy(arr[i+1:], arr[i:])
+	arr[i] = element
+	return arr
+}
+
+// MergeSorted creates a new sorted array with the elements of the arrays `a`
+// and `b`, both sorted according to the `less` function. Elements of `a` come
+// before equal elements of `b`.
+func MergeSorted[T any](a, b []T, less func(a, b T) bool) []T {
+	out := make([]T, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if less(b[j], a[i]) {
+			out = append(out, b[j])
+			j++
+		} else {
+			out = append(out, a[i])
+			i++
+		}
+	}
+	out = append(out, a[i:]...)
+	return append(out, b[j:]...)
+}
+
+// UniqSorted creates a new array with the elements of the sorted input array,
+// discarding consecutive duplicates.
+func UniqSorted[T comparable](in []T) []T {
+	out := make([]T, 0, len(in))
+	for i, val := range in {
+		if i == 0 || val != in[i-1] {
+			out = append(out, val)
+		}
+	}
+	return out
+}
@@ -0,0 +1,91 @@
+package array
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func compareInts(a, b int) int {
+	return a - b
+}
+
+func lessInts(a, b int) bool {
+	return a < b
+}
+
+func Test_BinarySearchBy(t *testing.T) {
+	arr := []int{1, 3, 3, 5, 7}
+	cases := map[int][2]int{
+		// target: {index or -1, insertion point}
+		0: {-1, 0},
+		1: {0, 0},
+		3: {1, 1},
+		4: {-1, 3},
+		7: {4, 4},
+		8: {-1, 5},
+	}
+	for target, expected := range cases {
+		found, insertion := BinarySearchBy(arr, target, compareInts)
+		assert.Equal(t, expected[0], found.OrElse(-1), "found %d", target)
+		assert.Equal(t, expected[1], insertion, "insertion %d", target)
+	}
+}
+
+func Test_BinarySearchBy_empty(t *testing.T) {
+	found, insertion := BinarySearchBy(nil, 1, compareInts)
+
+	assert.False(t, found.IsPresent())
+	assert.Equal(t, 0, insertion)
+}
+
+func Test_InsertSorted(t *testing.T) {
+	var arr []int
+	for _, v := range []int{5, 1, 4, 1, 9, 2} {
+		arr = InsertSorted(arr, v, compareInts)
+	}
+
+	assert.Equal(t, []int{1, 1, 2, 4, 5, 9}, arr)
+}
+
+func Test_InsertSorted_before_equal(t *testing.T) {
+	arr := []string{"a", "B", "c"}
+	arr = InsertSorted(arr, "b", func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+
+	assert.Equal(t, []string{"a", "b", "B", "c"}, arr)
+}
+
+func Test_MergeSorted(t *testing.T) {
+	a := []int{1, 4, 4, 9}
+	b := []int{2, 4, 10, 11}
+
+	assert.Equal(t, []int{1, 2, 4, 4, 4, 9, 10, 11}, MergeSorted(a, b, lessInts))
+	assert.Equal(t, []int{1, 4, 4, 9}, MergeSorted(a, nil, lessInts), "empty")
+}
+
+func Test_MergeSorted_stable(t *testing.T) {
+	a := []string{"a1", "b1"}
+	b := []string{"a2", "b2"}
+	actual := MergeSorted(a, b, func(x, y string) bool {
+		return x[0] < y[0]
+	})
+
+	assert.Equal(t, []string{"a1", "a2", "b1", "b2"}, actual)
+}
+
+func Test_UniqSorted(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, UniqSorted([]int{1, 1, 2, 3, 3, 3}))
+	assert.Equal(t, []int{}, UniqSorted([]int{}), "empty")
+}
+
+func Benchmark_MergeSorted(b *testing.B) {
+	x := SortBy(benchmarkData, func(v int) int { return v })
+	y := SortBy(benchmarkData, func(v int) int { return v })
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		MergeSorted(x, y, lessInts)
+	}
+}
@@ -0,0 +1,94 @@
+package array
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikhasd/fluent"
+)
+
+// IndexedError is an error returned by a function called on the element at
+// `Index` of an array.
+type IndexedError struct {
+	Index int
+	Err   error
+}
+
+func (e IndexedError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the error returned by the function.
+func (e IndexedError) Unwrap() error {
+	return e.Err
+}
+
+// Errors is a list of errors returned by a function called on the elements of
+// an array, in ascending order of index.
+type Errors []IndexedError
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// TryMap creates a new array populated with the result of calling the provided
+// `mapper` function on every element of the input array.
+//
+// TryMap stops at the first error returned by `mapper`, returning an Err
+// Result wrapping it in an IndexedError.
+func TryMap[I any, O any](in []I, mapper func(I) (O, error)) fluent.Result[[]O] {
+	out := make([]O, len(in))
+	for i, val := range in {
+		mapped, err := mapper(val)
+		if err != nil {
+			return fluent.Err[[]O](IndexedError{i, err})
+		}
+		out[i] = mapped
+	}
+	return fluent.Ok(out)
+}
+
+// TryMapAll creates a new array populated with the result of calling the
+// provided `mapper` function on every element of the input array.
+//
+// TryMapAll calls `mapper` on every element even if it fails, returning an Err
+// Result with the Errors of every failed call.
+func TryMapAll[I any, O any](in []I, mapper func(I) (O, error)) fluent.Result[[]O] {
+	out := make([]O, len(in))
+	var errs Errors
+	for i, val := range in {
+		mapped, err := mapper(val)
+		if err != nil {
+			errs = append(errs, IndexedError{i, err})
+			continue
+		}
+		out[i] = mapped
+	}
+	if len(errs) > 0 {
+		return fluent.Err[[]O](errs)
+	}
+	return fluent.Ok(out)
+}
+
+// TryFilter creates a new array with all elements that pass the provided
+// `condition` function.
+//
+// TryFilter stops at the first error returned by `condition`, returning an
+// Err Result wrapping it in an IndexedError.
+func TryFilter[T any](in []T, condition func(T) (bool, error)) fluent.Result[[]T] {
+	out := []T{}
+	for i, val := range in {
+		ok, err := condition(val)
+		if err != nil {
+			return fluent.Err[[]T](IndexedError{i, err})
+		}
+		if ok {
+			out = append(out, val)
+		}
+	}
+	return fluent.Ok(out)
+}
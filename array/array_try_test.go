@@ -0,0 +1,69 @@
+package array
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TryMap(t *testing.T) {
+	r := TryMap([]string{"1", "2", "3"}, strconv.Atoi)
+
+	assert.True(t, r.IsOk(), "ok")
+	assert.Equal(t, []int{1, 2, 3}, r.Get())
+}
+
+func Test_TryMap_error(t *testing.T) {
+	calls := 0
+	r := TryMap([]string{"1", "x", "y"}, func(s string) (int, error) {
+		calls++
+		return strconv.Atoi(s)
+	})
+
+	assert.True(t, r.IsErr(), "err")
+	assert.Equal(t, 2, calls, "stops on first error")
+
+	var indexed IndexedError
+	assert.True(t, errors.As(r.GetErr(), &indexed), "indexed")
+	assert.Equal(t, 1, indexed.Index)
+	assert.True(t, errors.Is(r.GetErr(), strconv.ErrSyntax), "unwraps")
+}
+
+func Test_TryMapAll(t *testing.T) {
+	r := TryMapAll([]string{"1", "2"}, strconv.Atoi)
+
+	assert.True(t, r.IsOk(), "ok")
+	assert.Equal(t, []int{1, 2}, r.Get())
+}
+
+func Test_TryMapAll_errors(t *testing.T) {
+	r := TryMapAll([]string{"x", "1", "y"}, strconv.Atoi)
+
+	assert.True(t, r.IsErr(), "err")
+	var errs Errors
+	assert.True(t, errors.As(r.GetErr(), &errs), "errors")
+	assert.Len(t, errs, 2)
+	assert.Equal(t, 0, errs[0].Index)
+	assert.Equal(t, 2, errs[1].Index)
+	assert.Equal(t, `index 0: strconv.Atoi: parsing "x": invalid syntax; index 2: strconv.Atoi: parsing "y": invalid syntax`, r.GetErr().Error())
+}
+
+func Test_TryFilter(t *testing.T) {
+	positive := func(s string) (bool, error) {
+		i, err := strconv.Atoi(s)
+		return i > 0, err
+	}
+
+	r := TryFilter([]string{"1", "-2", "3"}, positive)
+	assert.True(t, r.IsOk(), "ok")
+	assert.Equal(t, []string{"1", "3"}, r.Get())
+
+	r = TryFilter([]string{"1", "?"}, positive)
+	assert.True(t, r.IsErr(), "err")
+	assert.Equal(t, 1, r.GetErr().(IndexedError).Index)
+
+	r = TryFilter([]string{}, positive)
+	assert.Equal(t, []string{}, r.Get(), "empty")
+}
@@ -0,0 +1,194 @@
+package deque
+
+import (
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+)
+
+// minCapacity is the capacity of a Deque when its first element is added.
+const minCapacity = 8
+
+// Deque is a double-ended queue backed by a circular buffer, which grows as
+// elements are added and shrinks as they are removed. Adding and removing
+// elements at both ends and accessing an element by its position take a
+// constant time.
+//
+// A Deque is not safe for concurrent use.
+type Deque[T any] struct {
+	buffer []T
+	head   int
+	size   int
+}
+
+// New creates an empty Deque.
+func New[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// WithCapacity creates an empty Deque with room for `capacity` elements.
+func WithCapacity[T any](capacity int) *Deque[T] {
+	return &Deque[T]{
+		buffer: make([]T, capacity),
+	}
+}
+
+// index returns the position in the buffer of the i-th element.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.buffer)
+}
+
+// resize moves the elements to a new buffer of the given capacity.
+func (d *Deque[T]) resize(capacity int) {
+	buffer := make([]T, capacity)
+	if d.size > 0 {
+		if d.head+d.size <= len(d.buffer) {
+			copy(buffer, d.buffer[d.head:d.head+d.size])
+		} else {
+			n := copy(buffer, d.buffer[d.head:])
+			copy(buffer[n:], d.buffer[:d.size-n])
+		}
+	}
+	d.buffer = buffer
+	d.head = 0
+}
+
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buffer) {
+		return
+	}
+	capacity := 2 * len(d.buffer)
+	if capacity < minCapacity {
+		capacity = minCapacity
+	}
+	d.resize(capacity)
+}
+
+func (d *Deque[T]) shrink() {
+	if len(d.buffer) > minCapacity && d.size <= len(d.buffer)/4 {
+		d.resize(len(d.buffer) / 2)
+	}
+}
+
+// PushFront adds the element at the front of the deque.
+func (d *Deque[T]) PushFront(element T) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.buffer)) % len(d.buffer)
+	d.buffer[d.head] = element
+	d.size++
+}
+
+// PushBack adds the element at the back of the deque.
+func (d *Deque[T]) PushBack(element T) {
+	d.grow()
+	d.buffer[d.index(d.size)] = element
+	d.size++
+}
+
+// PopFront removes and returns the element at the front of the deque, if any.
+func (d *Deque[T]) PopFront() fluent.Option[T] {
+	if d.size == 0 {
+		return fluent.Empty[T]()
+	}
+	var zero T
+	element := d.buffer[d.head]
+	d.buffer[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	d.shrink()
+	return fluent.Present(element)
+}
+
+// PopBack removes and returns the element at the back of the deque, if any.
+func (d *Deque[T]) PopBack() fluent.Option[T] {
+	if d.size == 0 {
+		return fluent.Empty[T]()
+	}
+	var zero T
+	i := d.index(d.size - 1)
+	element := d.buffer[i]
+	d.buffer[i] = zero
+	d.size--
+	d.shrink()
+	return fluent.Present(element)
+}
+
+// Front returns the element at the front of the deque, if any.
+func (d *Deque[T]) Front() fluent.Option[T] {
+	return d.At(0)
+}
+
+// Back returns the element at the back of the deque, if any.
+func (d *Deque[T]) Back() fluent.Option[T] {
+	return d.At(d.size - 1)
+}
+
+// At returns the element at the position `i` from the front of the deque, or
+// an empty Option if `i` is out of range.
+func (d *Deque[T]) At(i int) fluent.Option[T] {
+	if i < 0 || i >= d.size {
+		return fluent.Empty[T]()
+	}
+	return fluent.Present(d.buffer[d.index(i)])
+}
+
+// Len returns the number of elements of the deque.
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// Empty returns true if the deque has no elements.
+func (d *Deque[T]) Empty() bool {
+	return d.size == 0
+}
+
+// Clear removes every element from the deque.
+func (d *Deque[T]) Clear() {
+	d.buffer = nil
+	d.head = 0
+	d.size = 0
+}
+
+// Implements iterator.Sized interface
+func (d *Deque[T]) Size() fluent.Option[int] {
+	return fluent.Present(d.size)
+}
+
+// Iterator returns an iterator over the elements of the deque, from front to
+// back. The deque must not be modified during the iteration.
+func (d *Deque[T]) Iterator() iterator.Iterator[T] {
+	return &bufferIterator[T]{
+		at:   d.At,
+		size: d.size,
+	}
+}
+
+// ToSlice returns a new array with the elements of the deque, from front to
+// back.
+func (d *Deque[T]) ToSlice() []T {
+	out := make([]T, d.size)
+	for i := range out {
+		out[i] = d.buffer[d.index(i)]
+	}
+	return out
+}
+
+// Buffer Iterator
+
+type bufferIterator[T any] struct {
+	at    func(int) fluent.Option[T]
+	index int
+	size  int
+}
+
+func (it *bufferIterator[T]) Next() fluent.Option[T] {
+	if it.index >= it.size {
+		return fluent.Empty[T]()
+	}
+	it.index++
+	return it.at(it.index - 1)
+}
+
+// Implements iterator.Sized interface
+func (it *bufferIterator[T]) Size() fluent.Option[int] {
+	return fluent.Present(it.size)
+}
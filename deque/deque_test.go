@@ -0,0 +1,167 @@
+package deque
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ iterator.Iterable[int] = New[int]()
+	_ iterator.Sized         = New[int]()
+)
+
+func collect[T any](it iterator.Iterator[T]) []T {
+	out := []T{}
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		out = append(out, o.Get())
+	}
+	return out
+}
+
+func Test_Deque_PushBack(t *testing.T) {
+	d := New[int]()
+	for i := 0; i < 20; i++ {
+		d.PushBack(i)
+	}
+
+	assert.Equal(t, 20, d.Len())
+	assert.Equal(t, 0, d.Front().Get(), "front")
+	assert.Equal(t, 19, d.Back().Get(), "back")
+	assert.Equal(t, 7, d.At(7).Get(), "at")
+}
+
+func Test_Deque_PushFront(t *testing.T) {
+	d := WithCapacity[string](0)
+	d.PushFront("b")
+	d.PushFront("a")
+	d.PushBack("c")
+
+	assert.Equal(t, []string{"a", "b", "c"}, d.ToSlice())
+}
+
+func Test_Deque_Pop(t *testing.T) {
+	d := New[int]()
+	for i := 0; i < 5; i++ {
+		d.PushBack(i)
+	}
+
+	assert.Equal(t, 0, d.PopFront().Get(), "front")
+	assert.Equal(t, 4, d.PopBack().Get(), "back")
+	assert.Equal(t, []int{1, 2, 3}, d.ToSlice())
+}
+
+func Test_Deque_Pop_empty(t *testing.T) {
+	d := New[int]()
+
+	assert.False(t, d.PopFront().IsPresent(), "front")
+	assert.False(t, d.PopBack().IsPresent(), "back")
+	assert.False(t, d.Front().IsPresent(), "peek front")
+	assert.False(t, d.Back().IsPresent(), "peek back")
+	assert.True(t, d.Empty())
+}
+
+func Test_Deque_At_out_of_range(t *testing.T) {
+	d := New[int]()
+	d.PushBack(1)
+
+	assert.False(t, d.At(-1).IsPresent())
+	assert.False(t, d.At(1).IsPresent())
+}
+
+func Test_Deque_wrap_around(t *testing.T) {
+	d := WithCapacity[int](4)
+	d.PushBack(1)
+	d.PushBack(2)
+	d.PopFront()
+	d.PushBack(3)
+	d.PushBack(4)
+	d.PushBack(5)
+
+	assert.Equal(t, []int{2, 3, 4, 5}, d.ToSlice(), "wrapped")
+	d.PushBack(6)
+	assert.Equal(t, []int{2, 3, 4, 5, 6}, d.ToSlice(), "grown")
+}
+
+func Test_Deque_shrink(t *testing.T) {
+	d := New[int]()
+	for i := 0; i < 1000; i++ {
+		d.PushBack(i)
+	}
+	for i := 0; i < 995; i++ {
+		d.PopFront()
+	}
+
+	assert.LessOrEqual(t, len(d.buffer), 32, "shrunk")
+	assert.Equal(t, []int{995, 996, 997, 998, 999}, d.ToSlice())
+}
+
+func Test_Deque_Pop_releases(t *testing.T) {
+	d := New[*int]()
+	v := 1
+	d.PushBack(&v)
+	d.PushBack(&v)
+	d.PopFront()
+	d.PopBack()
+
+	for _, p := range d.buffer {
+		assert.Nil(t, p)
+	}
+}
+
+func Test_Deque_random(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	d := New[int]()
+	var model []int
+	for i := 0; i < 10000; i++ {
+		switch random.Intn(4) {
+		case 0:
+			d.PushFront(i)
+			model = append([]int{i}, model...)
+		case 1:
+			d.PushBack(i)
+			model = append(model, i)
+		case 2:
+			o := d.PopFront()
+			if len(model) > 0 {
+				assert.Equal(t, model[0], o.Get())
+				model = model[1:]
+			} else {
+				assert.False(t, o.IsPresent())
+			}
+		case 3:
+			o := d.PopBack()
+			if len(model) > 0 {
+				assert.Equal(t, model[len(model)-1], o.Get())
+				model = model[:len(model)-1]
+			} else {
+				assert.False(t, o.IsPresent())
+			}
+		}
+	}
+	assert.Equal(t, len(model), d.Len())
+	assert.Equal(t, model, collect(d.Iterator()))
+}
+
+func Test_Deque_Iterator(t *testing.T) {
+	d := New[int]()
+	d.PushBack(2)
+	d.PushFront(1)
+	it := d.Iterator()
+
+	assert.Equal(t, 2, iterator.Size(it).Get(), "size")
+	assert.Equal(t, []int{1, 2}, collect(it))
+	assert.Equal(t, 2, d.Size().Get())
+}
+
+func Test_Deque_Clear(t *testing.T) {
+	d := New[int]()
+	d.PushBack(1)
+	d.Clear()
+
+	assert.True(t, d.Empty())
+	d.PushFront(2)
+	assert.Equal(t, []int{2}, d.ToSlice(), "reusable")
+}
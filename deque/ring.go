@@ -0,0 +1,124 @@
+package deque
+
+import (
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+)
+
+// Ring is a fixed-capacity circular buffer. Once full, pushing an element
+// overwrites the oldest element, so the ring holds the latest elements pushed,
+// as needed by sliding windows.
+//
+// A Ring is not safe for concurrent use.
+type Ring[T any] struct {
+	buffer []T
+	head   int
+	size   int
+}
+
+// NewRing creates an empty Ring holding up to `capacity` elements.
+//
+// NewRing panics if `capacity` is not positive.
+func NewRing[T any](capacity int) *Ring[T] {
+	if capacity <= 0 {
+		panic("ring capacity must be positive")
+	}
+	return &Ring[T]{
+		buffer: make([]T, capacity),
+	}
+}
+
+// Push adds the element to the ring. If the ring is full, the oldest element
+// is overwritten and returned.
+func (r *Ring[T]) Push(element T) fluent.Option[T] {
+	if r.size < len(r.buffer) {
+		r.buffer[(r.head+r.size)%len(r.buffer)] = element
+		r.size++
+		return fluent.Empty[T]()
+	}
+	evicted := r.buffer[r.head]
+	r.buffer[r.head] = element
+	r.head = (r.head + 1) % len(r.buffer)
+	return fluent.Present(evicted)
+}
+
+// Pop removes and returns the oldest element of the ring, if any.
+func (r *Ring[T]) Pop() fluent.Option[T] {
+	if r.size == 0 {
+		return fluent.Empty[T]()
+	}
+	var zero T
+	element := r.buffer[r.head]
+	r.buffer[r.head] = zero
+	r.head = (r.head + 1) % len(r.buffer)
+	r.size--
+	return fluent.Present(element)
+}
+
+// At returns the element at the position `i`, starting from the oldest
+// element, or an empty Option if `i` is out of range.
+func (r *Ring[T]) At(i int) fluent.Option[T] {
+	if i < 0 || i >= r.size {
+		return fluent.Empty[T]()
+	}
+	return fluent.Present(r.buffer[(r.head+i)%len(r.buffer)])
+}
+
+// Oldest returns the oldest element of the ring, if any.
+func (r *Ring[T]) Oldest() fluent.Option[T] {
+	return r.At(0)
+}
+
+// Newest returns the latest element pushed to the ring, if any.
+func (r *Ring[T]) Newest() fluent.Option[T] {
+	return r.At(r.size - 1)
+}
+
+// Len returns the number of elements of the ring.
+func (r *Ring[T]) Len() int {
+	return r.size
+}
+
+// Cap returns the maximum number of elements of the ring.
+func (r *Ring[T]) Cap() int {
+	return len(r.buffer)
+}
+
+// Full returns true if pushing an element overwrites the oldest element.
+func (r *Ring[T]) Full() bool {
+	return r.size == len(r.buffer)
+}
+
+// Clear removes every element from the ring.
+func (r *Ring[T]) Clear() {
+	var zero T
+	for i := range r.buffer {
+		r.buffer[i] = zero
+	}
+	r.head = 0
+	r.size = 0
+}
+
+// Implements iterator.Sized interface
+func (r *Ring[T]) Size() fluent.Option[int] {
+	return fluent.Present(r.size)
+}
+
+// Iterator returns an iterator over the elements of the ring, from the oldest
+// to the newest. The ring must not be modified during the iteration.
+func (r *Ring[T]) Iterator() iterator.Iterator[T] {
+	return &bufferIterator[T]{
+		at:   r.At,
+		size: r.size,
+	}
+}
+
+// ToSlice returns a new array with the elements of the ring, from the oldest
+// to the newest.
+func (r *Ring[T]) ToSlice() []T {
+	out := make([]T, r.size)
+	for i := range out {
+		out[i] = r.buffer[(r.head+i)%len(r.buffer)]
+	}
+	return out
+}
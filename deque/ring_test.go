@@ -0,0 +1,98 @@
+package deque
+
+import (
+	"testing"
+
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ iterator.Iterable[int] = NewRing[int](1)
+	_ iterator.Sized         = NewRing[int](1)
+)
+
+func Test_NewRing_invalid(t *testing.T) {
+	assert.Panics(t, func() { NewRing[int](0) })
+}
+
+func Test_Ring_Push(t *testing.T) {
+	r := NewRing[int](3)
+
+	assert.False(t, r.Push(1).IsPresent())
+	assert.False(t, r.Push(2).IsPresent())
+	assert.False(t, r.Push(3).IsPresent())
+	assert.True(t, r.Full(), "full")
+
+	evicted := r.Push(4)
+	assert.True(t, evicted.IsPresent(), "overwritten")
+	assert.Equal(t, 1, evicted.Get())
+	assert.Equal(t, []int{2, 3, 4}, r.ToSlice())
+	assert.Equal(t, 3, r.Len())
+	assert.Equal(t, 3, r.Cap())
+}
+
+func Test_Ring_Oldest_Newest(t *testing.T) {
+	r := NewRing[int](2)
+	assert.False(t, r.Oldest().IsPresent(), "empty")
+	assert.False(t, r.Newest().IsPresent(), "empty")
+
+	for i := 1; i <= 5; i++ {
+		r.Push(i)
+	}
+	assert.Equal(t, 4, r.Oldest().Get())
+	assert.Equal(t, 5, r.Newest().Get())
+	assert.Equal(t, 5, r.At(1).Get())
+	assert.False(t, r.At(2).IsPresent(), "out of range")
+}
+
+func Test_Ring_Pop(t *testing.T) {
+	r := NewRing[int](2)
+	r.Push(1)
+	r.Push(2)
+	r.Push(3)
+
+	assert.Equal(t, 2, r.Pop().Get())
+	assert.Equal(t, 3, r.Pop().Get())
+	assert.False(t, r.Pop().IsPresent())
+
+	r.Push(4)
+	assert.Equal(t, []int{4}, r.ToSlice(), "reusable")
+}
+
+func Test_Ring_Iterator(t *testing.T) {
+	r := NewRing[string](3)
+	for _, s := range []string{"a", "b", "c", "d"} {
+		r.Push(s)
+	}
+	it := r.Iterator()
+
+	assert.Equal(t, 3, iterator.Size(it).Get())
+	assert.Equal(t, []string{"b", "c", "d"}, collect(it))
+}
+
+func Test_Ring_sliding_average(t *testing.T) {
+	r := NewRing[float64](3)
+	var averages []float64
+	for _, v := range []float64{3, 6, 9, 12} {
+		r.Push(v)
+		sum := 0.0
+		for _, el := range r.ToSlice() {
+			sum += el
+		}
+		averages = append(averages, sum/float64(r.Len()))
+	}
+
+	assert.Equal(t, []float64{3, 4.5, 6, 9}, averages)
+}
+
+func Test_Ring_Clear(t *testing.T) {
+	r := NewRing[int](2)
+	r.Push(1)
+	r.Push(2)
+	r.Clear()
+
+	assert.Equal(t, 0, r.Len())
+	assert.Equal(t, 0, r.Size().Get())
+	assert.Empty(t, r.ToSlice())
+}
@@ -0,0 +1,414 @@
+// Package avl implements the AVL tree backing the sorted collections of the
+// module.
+package avl
+
+import (
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+)
+
+// Entry is a key of a Tree along with its value.
+type Entry[K any, V any] struct {
+	Key   K
+	Value V
+}
+
+// Tree is a balanced binary search tree mapping keys, ordered by a comparator
+// function, to values. Keys for which the comparator returns zero are the same
+// key.
+//
+// A Tree is not safe for concurrent use, and must not be modified while it is
+// iterated over.
+type Tree[K any, V any] struct {
+	root    *node[K, V]
+	size    int
+	compare func(a, b K) int
+}
+
+type node[K any, V any] struct {
+	entry       Entry[K, V]
+	left, right *node[K, V]
+	height      int
+}
+
+// New creates an empty Tree ordered by the `compare` function, which must
+// return a negative number if `a` is lower than `b`, zero if they are equal and
+// a positive number if `a` is greater than `b`.
+func New[K any, V any](compare func(a, b K) int) *Tree[K, V] {
+	return &Tree[K, V]{
+		compare: compare,
+	}
+}
+
+// Compare returns the comparator function ordering the tree.
+func (t *Tree[K, V]) Compare() func(a, b K) int {
+	return t.compare
+}
+
+// Len returns the number of keys of the tree.
+func (t *Tree[K, V]) Len() int {
+	return t.size
+}
+
+// Clear removes every key from the tree.
+func (t *Tree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Nodes are never shared between trees, as rotations modify them in place, so
+// cloning copies the whole tree.
+func (t *Tree[K, V]) Clone() *Tree[K, V] {
+	return &Tree[K, V]{
+		root:    cloneNode(t.root),
+		size:    t.size,
+		compare: t.compare,
+	}
+}
+
+func cloneNode[K any, V any](n *node[K, V]) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+	return &node[K, V]{
+		entry:  n.entry,
+		left:   cloneNode(n.left),
+		right:  cloneNode(n.right),
+		height: n.height,
+	}
+}
+
+func height[K any, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *node[K, V]) update() {
+	l, r := height(n.left), height(n.right)
+	if l > r {
+		n.height = l + 1
+	} else {
+		n.height = r + 1
+	}
+}
+
+func (n *node[K, V]) rotateLeft() *node[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
+
+func (n *node[K, V]) rotateRight() *node[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+func (n *node[K, V]) balance() *node[K, V] {
+	n.update()
+	switch factor := height(n.left) - height(n.right); {
+	case factor > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case factor < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+// Put associates the value with the key, returning the value it replaces, if
+// any. The key of an existing entry is replaced along with its value.
+func (t *Tree[K, V]) Put(key K, value V) fluent.Option[V] {
+	previous := fluent.Empty[V]()
+	t.root = t.insert(t.root, Entry[K, V]{Key: key, Value: value}, &previous)
+	return previous
+}
+
+func (t *Tree[K, V]) insert(n *node[K, V], e Entry[K, V], previous *fluent.Option[V]) *node[K, V] {
+	if n == nil {
+		t.size++
+		return &node[K, V]{entry: e, height: 1}
+	}
+	switch c := t.compare(e.Key, n.entry.Key); {
+	case c < 0:
+		n.left = t.insert(n.left, e, previous)
+	case c > 0:
+		n.right = t.insert(n.right, e, previous)
+	default:
+		*previous = fluent.Present(n.entry.Value)
+		n.entry = e
+		return n
+	}
+	return n.balance()
+}
+
+func removeMin[K any, V any](n *node[K, V]) (*node[K, V], *node[K, V]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var min *node[K, V]
+	n.left, min = removeMin(n.left)
+	return n.balance(), min
+}
+
+// Remove removes the key, returning the value associated with it, if any.
+func (t *Tree[K, V]) Remove(key K) fluent.Option[V] {
+	removed := fluent.Empty[V]()
+	t.root = t.delete(t.root, key, &removed)
+	return removed
+}
+
+func (t *Tree[K, V]) delete(n *node[K, V], key K, removed *fluent.Option[V]) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+	switch c := t.compare(key, n.entry.Key); {
+	case c < 0:
+		n.left = t.delete(n.left, key, removed)
+	case c > 0:
+		n.right = t.delete(n.right, key, removed)
+	default:
+		t.size--
+		*removed = fluent.Present(n.entry.Value)
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		right, min := removeMin(n.right)
+		min.left = n.left
+		min.right = right
+		n = min
+	}
+	return n.balance()
+}
+
+func (t *Tree[K, V]) find(key K) *node[K, V] {
+	n := t.root
+	for n != nil {
+		switch c := t.compare(key, n.entry.Key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// Get returns the value associated with the key, if any.
+func (t *Tree[K, V]) Get(key K) fluent.Option[V] {
+	if n := t.find(key); n != nil {
+		return fluent.Present(n.entry.Value)
+	}
+	return fluent.Empty[V]()
+}
+
+// Contains returns true if the key is present.
+func (t *Tree[K, V]) Contains(key K) bool {
+	return t.find(key) != nil
+}
+
+func walk[K any, V any](n *node[K, V], fn func(K, V)) {
+	for n != nil {
+		walk(n.left, fn)
+		fn(n.entry.Key, n.entry.Value)
+		n = n.right
+	}
+}
+
+// ForEach calls `fn` with each key and value, in ascending key order.
+func (t *Tree[K, V]) ForEach(fn func(K, V)) {
+	walk(t.root, fn)
+}
+
+// Min returns the entry with the lowest key, if any.
+func (t *Tree[K, V]) Min() fluent.Option[Entry[K, V]] {
+	n := t.root
+	if n == nil {
+		return fluent.Empty[Entry[K, V]]()
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return fluent.Present(n.entry)
+}
+
+// Max returns the entry with the highest key, if any.
+func (t *Tree[K, V]) Max() fluent.Option[Entry[K, V]] {
+	n := t.root
+	if n == nil {
+		return fluent.Empty[Entry[K, V]]()
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return fluent.Present(n.entry)
+}
+
+// Floor returns the entry with the greatest key lower than or equal to `key`,
+// if any.
+func (t *Tree[K, V]) Floor(key K) fluent.Option[Entry[K, V]] {
+	result := fluent.Empty[Entry[K, V]]()
+	for n := t.root; n != nil; {
+		switch c := t.compare(key, n.entry.Key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			result = fluent.Present(n.entry)
+			n = n.right
+		default:
+			return fluent.Present(n.entry)
+		}
+	}
+	return result
+}
+
+// Ceiling returns the entry with the least key greater than or equal to `key`,
+// if any.
+func (t *Tree[K, V]) Ceiling(key K) fluent.Option[Entry[K, V]] {
+	result := fluent.Empty[Entry[K, V]]()
+	for n := t.root; n != nil; {
+		switch c := t.compare(key, n.entry.Key); {
+		case c < 0:
+			result = fluent.Present(n.entry)
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return fluent.Present(n.entry)
+		}
+	}
+	return result
+}
+
+// Tree Iterator
+
+type treeIterator[K any, V any] struct {
+	stack      []*node[K, V]
+	descending bool
+	accept     func(K) bool
+	size       fluent.Option[int]
+}
+
+func (it *treeIterator[K, V]) push(n *node[K, V]) {
+	for n != nil {
+		it.stack = append(it.stack, n)
+		if it.descending {
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+}
+
+func (it *treeIterator[K, V]) Next() fluent.Option[Entry[K, V]] {
+	last := len(it.stack) - 1
+	if last < 0 {
+		return fluent.Empty[Entry[K, V]]()
+	}
+	n := it.stack[last]
+	it.stack = it.stack[:last]
+	if !it.accept(n.entry.Key) {
+		it.stack = it.stack[:0]
+		return fluent.Empty[Entry[K, V]]()
+	}
+	if it.descending {
+		it.push(n.left)
+	} else {
+		it.push(n.right)
+	}
+	return fluent.Present(n.entry)
+}
+
+// Implements iterator.Sized interface
+func (it *treeIterator[K, V]) Size() fluent.Option[int] {
+	return it.size
+}
+
+func acceptAll[K any](K) bool {
+	return true
+}
+
+// Ascending returns an iterator over the entries of the tree in ascending key
+// order.
+func (t *Tree[K, V]) Ascending() iterator.Iterator[Entry[K, V]] {
+	it := &treeIterator[K, V]{
+		accept: acceptAll[K],
+		size:   fluent.Present(t.size),
+	}
+	it.push(t.root)
+	return it
+}
+
+// Descending returns an iterator over the entries of the tree in descending
+// key order.
+func (t *Tree[K, V]) Descending() iterator.Iterator[Entry[K, V]] {
+	it := &treeIterator[K, V]{
+		descending: true,
+		accept:     acceptAll[K],
+		size:       fluent.Present(t.size),
+	}
+	it.push(t.root)
+	return it
+}
+
+// Range returns an iterator, in ascending order, over the entries of the tree
+// with keys from `from`, inclusive, to `to`, exclusive.
+func (t *Tree[K, V]) Range(from, to K) iterator.Iterator[Entry[K, V]] {
+	it := &treeIterator[K, V]{
+		accept: func(key K) bool {
+			return t.compare(key, to) < 0
+		},
+		size: fluent.Empty[int](),
+	}
+	for n := t.root; n != nil; {
+		if t.compare(n.entry.Key, from) >= 0 {
+			it.stack = append(it.stack, n)
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return it
+}
+
+// Keys Iterator
+
+type keysIterator[K any, V any] struct {
+	entries iterator.Iterator[Entry[K, V]]
+}
+
+// Keys returns an iterator over the keys of the entries iterator.
+func Keys[K any, V any](entries iterator.Iterator[Entry[K, V]]) iterator.Iterator[K] {
+	return keysIterator[K, V]{
+		entries: entries,
+	}
+}
+
+func (it keysIterator[K, V]) Next() fluent.Option[K] {
+	return fluent.MapOption(it.entries.Next(), func(e Entry[K, V]) K {
+		return e.Key
+	})
+}
+
+// Implements iterator.Sized interface
+func (it keysIterator[K, V]) Size() fluent.Option[int] {
+	return iterator.Size(it.entries)
+}
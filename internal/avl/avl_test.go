@@ -0,0 +1,157 @@
+package avl
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+func compareInts(a, b int) int {
+	return a - b
+}
+
+func drain[T any](it iterator.Iterator[T]) []T {
+	out := []T{}
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		out = append(out, o.Get())
+	}
+	return out
+}
+
+func treeOf(keys ...int) *Tree[int, string] {
+	t := New[int, string](compareInts)
+	for _, k := range keys {
+		t.Put(k, "")
+	}
+	return t
+}
+
+// checkTree verifies the AVL invariants and returns the height of the tree.
+func checkTree(t *testing.T, n *node[int, int]) int {
+	if n == nil {
+		return 0
+	}
+	l, r := checkTree(t, n.left), checkTree(t, n.right)
+	assert.LessOrEqual(t, l-r, 1, "balanced")
+	assert.GreaterOrEqual(t, l-r, -1, "balanced")
+	h := l + 1
+	if r > l {
+		h = r + 1
+	}
+	assert.Equal(t, h, n.height, "height")
+	return h
+}
+
+func Test_Tree_Put_Remove(t *testing.T) {
+	tree := New[string, int](func(a, b string) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	})
+
+	assert.False(t, tree.Put("a", 1).IsPresent(), "new key")
+	assert.Equal(t, 1, tree.Put("a", 2).Get(), "replaced")
+	assert.Equal(t, 2, tree.Get("a").Get())
+	assert.True(t, tree.Contains("a"))
+	assert.Equal(t, 1, tree.Len())
+
+	assert.Equal(t, 2, tree.Remove("a").Get(), "removed")
+	assert.False(t, tree.Remove("a").IsPresent(), "absent")
+	assert.False(t, tree.Get("a").IsPresent())
+	assert.Zero(t, tree.Len())
+}
+
+func Test_Tree_random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	tree := New[int, int](compareInts)
+	expected := make(map[int]int)
+
+	for i := 0; i < 5000; i++ {
+		k := r.Intn(500)
+		if r.Intn(3) == 0 {
+			v, found := expected[k]
+			removed := tree.Remove(k)
+			assert.Equal(t, found, removed.IsPresent())
+			if found {
+				assert.Equal(t, v, removed.Get())
+			}
+			delete(expected, k)
+		} else {
+			tree.Put(k, i)
+			expected[k] = i
+		}
+	}
+
+	keys := make([]int, 0, len(expected))
+	for k := range expected {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	assert.Equal(t, keys, drain(Keys(tree.Ascending())), "keys")
+	assert.Equal(t, len(keys), tree.Len(), "size")
+	for k, v := range expected {
+		assert.Equal(t, v, tree.Get(k).Get())
+	}
+	checkTree(t, tree.root)
+}
+
+func Test_Tree_order(t *testing.T) {
+	tree := treeOf(5, 3, 9, 1, 7)
+	var walked []int
+	tree.ForEach(func(k int, _ string) {
+		walked = append(walked, k)
+	})
+
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, walked, "ForEach")
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, drain(Keys(tree.Ascending())), "Ascending")
+	assert.Equal(t, []int{9, 7, 5, 3, 1}, drain(Keys(tree.Descending())), "Descending")
+	assert.Equal(t, 5, iterator.Size(Keys(tree.Ascending())).Get(), "size")
+}
+
+func Test_Tree_navigation(t *testing.T) {
+	tree := treeOf(10, 20, 30, 40)
+
+	assert.Equal(t, 10, tree.Min().Get().Key, "min")
+	assert.Equal(t, 40, tree.Max().Get().Key, "max")
+	assert.Equal(t, 20, tree.Floor(25).Get().Key, "floor")
+	assert.Equal(t, 20, tree.Floor(20).Get().Key, "floor exact")
+	assert.False(t, tree.Floor(5).IsPresent(), "floor absent")
+	assert.Equal(t, 30, tree.Ceiling(25).Get().Key, "ceiling")
+	assert.False(t, tree.Ceiling(45).IsPresent(), "ceiling absent")
+
+	empty := treeOf()
+	assert.False(t, empty.Min().IsPresent(), "empty min")
+	assert.False(t, empty.Max().IsPresent(), "empty max")
+}
+
+func Test_Tree_Range(t *testing.T) {
+	tree := treeOf(10, 20, 30, 40, 50)
+
+	assert.Equal(t, []int{20, 30, 40}, drain(Keys(tree.Range(20, 50))), "inclusive from")
+	assert.Equal(t, []int{20, 30}, drain(Keys(tree.Range(15, 35))), "between")
+	assert.Empty(t, drain(Keys(tree.Range(51, 60))), "after")
+	assert.Empty(t, drain(Keys(tree.Range(30, 30))), "empty")
+	assert.False(t, iterator.Size(tree.Range(0, 100)).IsPresent(), "size")
+}
+
+func Test_Tree_Clone(t *testing.T) {
+	tree := treeOf(1, 2, 3)
+	clone := tree.Clone()
+	clone.Put(4, "")
+	tree.Remove(1)
+
+	assert.Equal(t, []int{2, 3}, drain(Keys(tree.Ascending())))
+	assert.Equal(t, []int{1, 2, 3, 4}, drain(Keys(clone.Ascending())))
+
+	tree.Clear()
+	assert.Zero(t, tree.Len())
+	assert.False(t, tree.Min().IsPresent())
+}
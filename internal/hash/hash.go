@@ -0,0 +1,164 @@
+// Package hash computes hashes of comparable values consistent with the ==
+// operator, shared by the hash based collections of the module.
+package hash
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+)
+
+const (
+	offset64 = 14695981039346656037
+	prime64  = 1099511628211
+
+	// nilTag is hashed in place of nil interfaces
+	nilTag = 0x9e3779b97f4a7c15
+)
+
+// Of computes a 64 bits hash of a comparable value: values equal according to
+// the == operator always produce the same hash.
+//
+// Values are hashed from a little-endian encoding using FNV-1a, whose result
+// is then mixed. Integers of any size and floats of any precision holding the
+// same number produce the same hash, and +0 and -0 are hashed alike, also
+// inside structs and arrays. Pointers, channels and the pointers held by
+// interfaces are hashed by address, never by the value they point to.
+//
+// Hashes of values without pointers are stable across processes and
+// platforms; hashes of pointers are only stable within the same process.
+func Of[T comparable](value T) uint64 {
+	h := state(offset64)
+	switch v := any(value).(type) {
+	case int:
+		h.writeUint(uint64(v))
+	case int8:
+		h.writeUint(uint64(v))
+	case int16:
+		h.writeUint(uint64(v))
+	case int32:
+		h.writeUint(uint64(v))
+	case int64:
+		h.writeUint(uint64(v))
+	case uint:
+		h.writeUint(uint64(v))
+	case uint8:
+		h.writeUint(uint64(v))
+	case uint16:
+		h.writeUint(uint64(v))
+	case uint32:
+		h.writeUint(uint64(v))
+	case uint64:
+		h.writeUint(v)
+	case uintptr:
+		h.writeUint(uint64(v))
+	case float32:
+		h.writeFloat(float64(v))
+	case float64:
+		h.writeFloat(v)
+	case bool:
+		h.writeBool(v)
+	case string:
+		h.writeString(v)
+	default:
+		h.writeValue(reflect.ValueOf(value), false)
+	}
+	return Mix(uint64(h))
+}
+
+// Mix spreads the bits of a hash using the finalizer of the SplitMix64
+// generator.
+func Mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// state is the running FNV-1a hash.
+type state uint64
+
+func (h *state) writeUint(v uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	for _, b := range buf {
+		*h ^= state(b)
+		*h *= prime64
+	}
+}
+
+func (h *state) writeFloat(f float64) {
+	if f == 0 {
+		// +0 and -0 are equal
+		f = 0
+	}
+	h.writeUint(math.Float64bits(f))
+}
+
+func (h *state) writeBool(v bool) {
+	if v {
+		h.writeUint(1)
+	} else {
+		h.writeUint(0)
+	}
+}
+
+func (h *state) writeString(s string) {
+	for i := 0; i < len(s); i++ {
+		*h ^= state(s[i])
+		*h *= prime64
+	}
+}
+
+// writeValue hashes the value field by field. Strings nested in composite
+// values are prefixed by their length, so adjacent strings do not run into
+// each other.
+func (h *state) writeValue(v reflect.Value, nested bool) {
+	switch v.Kind() {
+	case reflect.Invalid:
+		// A nil interface hashed as an interface type parameter
+		h.writeUint(nilTag)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		h.writeUint(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		h.writeUint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		h.writeFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		h.writeFloat(real(c))
+		h.writeFloat(imag(c))
+	case reflect.Bool:
+		h.writeBool(v.Bool())
+	case reflect.String:
+		if nested {
+			h.writeUint(uint64(v.Len()))
+		}
+		h.writeString(v.String())
+	case reflect.Ptr, reflect.Chan, reflect.UnsafePointer:
+		h.writeUint(uint64(v.Pointer()))
+	case reflect.Interface:
+		if v.IsNil() {
+			h.writeUint(nilTag)
+		} else {
+			h.writeValue(v.Elem(), true)
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			h.writeValue(v.Index(i), true)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			// Blank fields are ignored by ==
+			if t.Field(i).Name != "_" {
+				h.writeValue(v.Field(i), true)
+			}
+		}
+	default:
+		// Remaining kinds are not comparable, == panics on them
+		panic("hash of uncomparable type " + v.Type().String())
+	}
+}
@@ -0,0 +1,131 @@
+package hash
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type point struct {
+	X, Y float64
+}
+
+type named struct {
+	name  string
+	label string
+}
+
+type holder struct {
+	ptr *point
+	ch  chan int
+}
+
+// boxed holds an interface, so it only satisfies comparable from Go 1.20 and
+// is hashed through writeValue.
+type boxed struct {
+	value interface{}
+}
+
+func hashValue(v interface{}) uint64 {
+	h := state(offset64)
+	h.writeValue(reflect.ValueOf(v), false)
+	return Mix(uint64(h))
+}
+
+type padded struct {
+	A int
+	_ int
+}
+
+type ID int
+
+func Test_Of(t *testing.T) {
+	assert.Equal(t, Of(42), Of(42), "int")
+	assert.NotEqual(t, Of(42), Of(43), "int")
+	assert.Equal(t, Of(int8(1)), Of(uint64(1)), "integers")
+	assert.Equal(t, Of(7), Of(ID(7)), "named integer")
+	assert.Equal(t, Of("fluent"), Of("fluent"), "string")
+	assert.NotEqual(t, Of("fluent"), Of("fluenT"), "string")
+	assert.Equal(t, Of(float32(1.5)), Of(1.5), "float")
+	assert.Equal(t, Of(0.0), Of(math.Copysign(0, -1)), "signed zero")
+	assert.NotEqual(t, Of(true), Of(false), "bool")
+}
+
+func Test_Of_integers(t *testing.T) {
+	expected := Of(7)
+
+	assert.Equal(t, expected, Of(int16(7)), "int16")
+	assert.Equal(t, expected, Of(int32(7)), "int32")
+	assert.Equal(t, expected, Of(int64(7)), "int64")
+	assert.Equal(t, expected, Of(uint(7)), "uint")
+	assert.Equal(t, expected, Of(uint8(7)), "uint8")
+	assert.Equal(t, expected, Of(uint16(7)), "uint16")
+	assert.Equal(t, expected, Of(uint32(7)), "uint32")
+	assert.Equal(t, expected, Of(uintptr(7)), "uintptr")
+}
+
+func Test_Of_stable(t *testing.T) {
+	// Serialized filters depend on hashes not changing between releases.
+	assert.Equal(t, uint64(0xdab73f9ae2411714), Of("fluent"))
+	assert.Equal(t, uint64(0xe15f07fef55b9454), Of(42))
+}
+
+func Test_Of_struct(t *testing.T) {
+	assert.Equal(t, Of(point{1, 2}), Of(point{1, 2}))
+	assert.NotEqual(t, Of(point{1, 2}), Of(point{2, 1}))
+	assert.NotEqual(t, Of(named{"ab", "c"}), Of(named{"a", "bc"}), "nested strings")
+	assert.Equal(t, Of(padded{A: 1}), Of(padded{A: 1}), "blank field")
+}
+
+func Test_Of_signedZero(t *testing.T) {
+	negative := math.Copysign(0, -1)
+	assert.True(t, point{0, 1} == point{negative, 1})
+	assert.Equal(t, Of(point{0, 1}), Of(point{negative, 1}), "struct")
+	assert.Equal(t, Of([2]float64{0, 0}), Of([2]float64{negative, negative}), "array")
+	assert.Equal(t, Of(complex(0, 1)), Of(complex(negative, 1)), "complex")
+}
+
+func Test_Of_pointer(t *testing.T) {
+	p := &point{1, 2}
+	before := Of(p)
+	p.X = 3
+
+	assert.Equal(t, before, Of(p), "pointee changed")
+	assert.NotEqual(t, Of(p), Of(&point{3, 2}), "other pointer to an equal value")
+	assert.Equal(t, Of(holder{ptr: p}), Of(holder{ptr: p}), "pointer field")
+}
+
+func Test_Of_channel(t *testing.T) {
+	ch := make(chan int)
+
+	assert.Equal(t, Of(holder{ch: ch}), Of(holder{ch: ch}))
+	assert.NotEqual(t, Of(holder{ch: ch}), Of(holder{ch: make(chan int)}))
+}
+
+func Test_writeValue_interface(t *testing.T) {
+	p := &point{1, 2}
+	before := hashValue(boxed{p})
+	p.Y = 5
+
+	assert.Equal(t, before, hashValue(boxed{p}), "pointer in interface")
+	assert.Equal(t, hashValue(boxed{0.0}), hashValue(boxed{math.Copysign(0, -1)}), "float in interface")
+	assert.Equal(t, hashValue(boxed{}), hashValue(boxed{}), "nil interface")
+}
+
+// Of[any](nil) requires Go 1.20 for any to satisfy comparable, hashValue
+// follows the same path.
+func Test_writeValue_nil(t *testing.T) {
+	assert.NotPanics(t, func() { hashValue(nil) }, "nil interface")
+	assert.Equal(t, hashValue(nil), hashValue(nil))
+	assert.Equal(t, hashValue(boxed{}), hashValue(boxed{nil}), "nil in interface field")
+	assert.NotEqual(t, hashValue(boxed{}), hashValue(boxed{0}), "nil and zero")
+}
+
+func Benchmark_Of_struct(b *testing.B) {
+	v := named{"fluent", "hash"}
+	for i := 0; i < b.N; i++ {
+		Of(v)
+	}
+}
@@ -0,0 +1,176 @@
+// Package linked implements the hash map with insertion order iteration
+// backing the linked collections of the module.
+package linked
+
+import (
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+)
+
+// Node holds a key of a Map along with its value.
+type Node[K comparable, V any] struct {
+	Key   K
+	Value V
+
+	prev, next *Node[K, V]
+	removed    bool
+}
+
+// live returns the first node, starting from `n`, still in its map. Removed
+// nodes keep their `next` reference, so a node removed while an iterator is
+// positioned on it still leads to the following nodes.
+func live[K comparable, V any](n *Node[K, V]) *Node[K, V] {
+	for n != nil && n.removed {
+		n = n.next
+	}
+	return n
+}
+
+// Next returns the node following this one which is still in the map, or nil.
+func (n *Node[K, V]) Next() *Node[K, V] {
+	return live(n.next)
+}
+
+// Map is a hash map iterating over its keys in the order they were first
+// added. Associating a new value with a present key keeps its position.
+//
+// Keys removed while iterating are never returned by the iteration. Keys
+// added while iterating may or may not be returned.
+type Map[K comparable, V any] struct {
+	items map[K]*Node[K, V]
+	head  *Node[K, V]
+	tail  *Node[K, V]
+}
+
+// New creates an empty Map with room for `size` keys.
+func New[K comparable, V any](size int) *Map[K, V] {
+	return &Map[K, V]{
+		items: make(map[K]*Node[K, V], size),
+	}
+}
+
+// Find returns the node holding the key, or nil if the key is absent.
+func (m *Map[K, V]) Find(key K) *Node[K, V] {
+	return m.items[key]
+}
+
+// Put associates the value with the key, returning the value it replaces, if
+// any.
+func (m *Map[K, V]) Put(key K, value V) fluent.Option[V] {
+	if node, found := m.items[key]; found {
+		previous := node.Value
+		node.Value = value
+		return fluent.Present(previous)
+	}
+	node := &Node[K, V]{
+		Key:   key,
+		Value: value,
+		prev:  m.tail,
+	}
+	if m.tail == nil {
+		m.head = node
+	} else {
+		m.tail.next = node
+	}
+	m.tail = node
+	m.items[key] = node
+	return fluent.Empty[V]()
+}
+
+// Remove removes the key, returning the value associated with it, if any.
+func (m *Map[K, V]) Remove(key K) fluent.Option[V] {
+	node, found := m.items[key]
+	if !found {
+		return fluent.Empty[V]()
+	}
+	delete(m.items, key)
+	if node.prev == nil {
+		m.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next == nil {
+		m.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+	node.removed = true
+	node.prev = nil
+	return fluent.Present(node.Value)
+}
+
+// Clear removes every key from the map.
+func (m *Map[K, V]) Clear() {
+	for node := m.head; node != nil; node = node.next {
+		node.removed = true
+	}
+	m.items = make(map[K]*Node[K, V])
+	m.head = nil
+	m.tail = nil
+}
+
+// Len returns the number of keys of the map.
+func (m *Map[K, V]) Len() int {
+	return len(m.items)
+}
+
+// First returns the node of the oldest key, or nil if the map is empty.
+func (m *Map[K, V]) First() *Node[K, V] {
+	return m.head
+}
+
+// Last returns the node of the newest key, or nil if the map is empty.
+func (m *Map[K, V]) Last() *Node[K, V] {
+	return m.tail
+}
+
+// ForEach calls `fn` with each key and value, in insertion order.
+func (m *Map[K, V]) ForEach(fn func(K, V)) {
+	for node := m.head; node != nil; node = node.Next() {
+		fn(node.Key, node.Value)
+	}
+}
+
+// Node Iterator
+
+// nodeIterator does not implement iterator.Sized: the number of nodes it
+// returns changes as keys are added or removed while iterating.
+type nodeIterator[K comparable, V any] struct {
+	next *Node[K, V]
+}
+
+func (it *nodeIterator[K, V]) Next() fluent.Option[*Node[K, V]] {
+	node := live(it.next)
+	if node == nil {
+		it.next = nil
+		return fluent.Empty[*Node[K, V]]()
+	}
+	it.next = node.next
+	return fluent.Present(node)
+}
+
+// Nodes returns an iterator over the nodes of the map, in insertion order.
+func (m *Map[K, V]) Nodes() iterator.Iterator[*Node[K, V]] {
+	return &nodeIterator[K, V]{
+		next: m.head,
+	}
+}
+
+// Keys Iterator
+
+type keysIterator[K comparable, V any] struct {
+	nodes iterator.Iterator[*Node[K, V]]
+}
+
+// Keys returns an iterator over the keys of the map, in insertion order.
+func (m *Map[K, V]) Keys() iterator.Iterator[K] {
+	return keysIterator[K, V]{
+		nodes: m.Nodes(),
+	}
+}
+
+func (it keysIterator[K, V]) Next() fluent.Option[K] {
+	return fluent.MapOption(it.nodes.Next(), func(n *Node[K, V]) K {
+		return n.Key
+	})
+}
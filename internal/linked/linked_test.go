@@ -0,0 +1,118 @@
+package linked
+
+import (
+	"testing"
+
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+func drain[T any](it iterator.Iterator[T]) []T {
+	out := []T{}
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		out = append(out, o.Get())
+	}
+	return out
+}
+
+func mapOf(keys ...string) *Map[string, int] {
+	m := New[string, int](len(keys))
+	for i, k := range keys {
+		m.Put(k, i)
+	}
+	return m
+}
+
+func Test_Map_Put(t *testing.T) {
+	m := mapOf("c", "a", "b")
+
+	assert.Equal(t, 0, m.Put("c", 10).Get(), "replaced")
+	assert.False(t, m.Put("d", 3).IsPresent(), "new key")
+	assert.Equal(t, []string{"c", "a", "b", "d"}, drain(m.Keys()))
+	assert.Equal(t, 10, m.Find("c").Value)
+	assert.Nil(t, m.Find("e"))
+	assert.Equal(t, 4, m.Len())
+	assert.Equal(t, "c", m.First().Key)
+	assert.Equal(t, "d", m.Last().Key)
+}
+
+func Test_Map_Remove(t *testing.T) {
+	m := mapOf("a", "b", "c", "d")
+
+	assert.Equal(t, 0, m.Remove("a").Get(), "head")
+	assert.Equal(t, 2, m.Remove("c").Get(), "inner")
+	assert.Equal(t, 3, m.Remove("d").Get(), "tail")
+	assert.False(t, m.Remove("d").IsPresent(), "absent")
+	assert.Equal(t, []string{"b"}, drain(m.Keys()))
+	assert.Equal(t, "b", m.First().Key)
+	assert.Equal(t, "b", m.Last().Key)
+
+	m.Clear()
+	assert.Zero(t, m.Len())
+	assert.Nil(t, m.First())
+	assert.Nil(t, m.Last())
+}
+
+func Test_Map_removeCurrent(t *testing.T) {
+	m := mapOf("a", "b", "c")
+	it := m.Keys()
+	var visited []string
+	for k := it.Next(); k.IsPresent(); k = it.Next() {
+		visited = append(visited, k.Get())
+		m.Remove(k.Get())
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, visited)
+	assert.Zero(t, m.Len())
+}
+
+func Test_Map_removeNext(t *testing.T) {
+	m := mapOf("a", "b", "c", "d")
+	it := m.Keys()
+
+	assert.Equal(t, "a", it.Next().Get())
+	m.Remove("b")
+	m.Remove("c")
+	assert.Equal(t, "d", it.Next().Get(), "removed keys skipped")
+	assert.False(t, it.Next().IsPresent())
+}
+
+func Test_Map_removeAfterCurrent(t *testing.T) {
+	m := mapOf("a", "b", "c")
+	it := m.Keys()
+
+	assert.Equal(t, "a", it.Next().Get())
+	m.Remove("a")
+	m.Remove("b")
+	m.Put("e", 4)
+	assert.Equal(t, []string{"c", "e"}, drain(it))
+}
+
+func Test_Map_Clear_whileIterating(t *testing.T) {
+	m := mapOf("a", "b")
+	it := m.Keys()
+
+	assert.Equal(t, "a", it.Next().Get())
+	m.Clear()
+	assert.False(t, it.Next().IsPresent())
+}
+
+func Test_Map_ForEach_remove(t *testing.T) {
+	m := mapOf("a", "b", "c", "d")
+	var visited []string
+	m.ForEach(func(k string, _ int) {
+		visited = append(visited, k)
+		if k == "a" {
+			m.Remove("b")
+		}
+	})
+
+	assert.Equal(t, []string{"a", "c", "d"}, visited)
+}
+
+func Test_Map_Nodes_unsized(t *testing.T) {
+	m := mapOf("a", "b")
+
+	assert.False(t, iterator.Size(m.Nodes()).IsPresent(), "nodes")
+	assert.False(t, iterator.Size(m.Keys()).IsPresent(), "keys")
+}
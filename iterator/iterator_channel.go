@@ -0,0 +1,23 @@
+package iterator
+
+import "github.com/mikhasd/fluent"
+
+type channelIterator[T any] struct {
+	source <-chan T
+}
+
+// FromChannel creates a new `Iterator` receiving the elements sent to the
+// given channel. The iteration blocks while waiting for elements and
+// finishes when the channel is closed.
+func FromChannel[T any](ch <-chan T) Iterator[T] {
+	return channelIterator[T]{
+		source: ch,
+	}
+}
+
+func (it channelIterator[T]) Next() fluent.Option[T] {
+	if value, ok := <-it.source; ok {
+		return fluent.Present(value)
+	}
+	return fluent.Empty[T]()
+}
@@ -0,0 +1,31 @@
+package iterator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_channelIterator_Next(t *testing.T) {
+	ch := make(chan int, len(arrayTestData))
+	for _, val := range arrayTestData {
+		ch <- val
+	}
+	close(ch)
+
+	it := FromChannel(ch)
+	for _, val := range arrayTestData {
+		o := it.Next()
+		assert.True(t, o.IsPresent(), "present")
+		assert.Equal(t, val, o.Get())
+	}
+
+	o := it.Next()
+	assert.False(t, o.IsPresent(), "present")
+}
+
+func Test_channelIterator_Size(t *testing.T) {
+	it := FromChannel(make(chan int))
+	o := Size(it)
+	assert.False(t, o.IsPresent(), "present")
+}
@@ -0,0 +1,168 @@
+package iterator
+
+import "github.com/mikhasd/fluent"
+
+// Chunk
+
+type chunkIterator[T any] struct {
+	size   int
+	source Iterator[T]
+	done   bool
+}
+
+// Chunk creates an `Iterator` that groups the elements of the source iterator
+// into arrays of `size` elements. The last array may be shorter if the number
+// of elements is not a multiple of `size`.
+//
+// Chunk panics if `size` is not positive.
+func Chunk[T any](it Iterator[T], size int) Iterator[[]T] {
+	if size <= 0 {
+		panic("chunk size must be positive")
+	}
+	return &chunkIterator[T]{
+		size:   size,
+		source: it,
+	}
+}
+
+func (c *chunkIterator[T]) Next() fluent.Option[[]T] {
+	if c.done {
+		return fluent.Empty[[]T]()
+	}
+	var chunk []T
+	for len(chunk) < c.size {
+		o := c.source.Next()
+		if !o.IsPresent() {
+			c.done = true
+			break
+		}
+		if chunk == nil {
+			chunk = make([]T, 0, c.size)
+		}
+		chunk = append(chunk, o.Get())
+	}
+	if len(chunk) == 0 {
+		return fluent.Empty[[]T]()
+	}
+	return fluent.Present(chunk)
+}
+
+// Implements iterator.Sized interface
+func (c *chunkIterator[T]) Size() fluent.Option[int] {
+	return Size(c.source).Map(func(size int) int {
+		return (size + c.size - 1) / c.size
+	})
+}
+
+// Window
+
+type windowIterator[T any] struct {
+	size   int
+	step   int
+	buffer []T
+	source Iterator[T]
+	done   bool
+}
+
+// Window creates an `Iterator` of sliding windows over the source iterator.
+// Each window holds `size` consecutive elements and starts `step` elements
+// after the previous one. Incomplete trailing windows are discarded.
+//
+// Window panics if `size` or `step` are not positive.
+func Window[T any](it Iterator[T], size, step int) Iterator[[]T] {
+	if size <= 0 {
+		panic("window size must be positive")
+	}
+	if step <= 0 {
+		panic("window step must be positive")
+	}
+	return &windowIterator[T]{
+		size:   size,
+		step:   step,
+		buffer: make([]T, 0, size),
+		source: it,
+	}
+}
+
+func (w *windowIterator[T]) Next() fluent.Option[[]T] {
+	if w.done {
+		return fluent.Empty[[]T]()
+	}
+	if len(w.buffer) == w.size {
+		if w.step < w.size {
+			n := copy(w.buffer, w.buffer[w.step:])
+			w.buffer = w.buffer[:n]
+		} else {
+			w.buffer = w.buffer[:0]
+			for i := w.size; i < w.step; i++ {
+				if !w.source.Next().IsPresent() {
+					w.done = true
+					return fluent.Empty[[]T]()
+				}
+			}
+		}
+	}
+	for len(w.buffer) < w.size {
+		o := w.source.Next()
+		if !o.IsPresent() {
+			w.done = true
+			return fluent.Empty[[]T]()
+		}
+		w.buffer = append(w.buffer, o.Get())
+	}
+	window := make([]T, w.size)
+	copy(window, w.buffer)
+	return fluent.Present(window)
+}
+
+// Implements iterator.Sized interface
+func (w *windowIterator[T]) Size() fluent.Option[int] {
+	return Size(w.source).Map(func(size int) int {
+		if size < w.size {
+			return 0
+		}
+		return (size-w.size)/w.step + 1
+	})
+}
+
+// ChunkBy
+
+type chunkByIterator[T any, K comparable] struct {
+	key     func(T) K
+	source  Iterator[T]
+	pending fluent.Option[T]
+}
+
+// ChunkBy creates an `Iterator` that groups consecutive elements of the
+// source iterator sharing the same key, as computed by the `key` function.
+//
+// Unlike a grouping operation, elements with the same key that are not
+// adjacent are placed into different arrays.
+func ChunkBy[T any, K comparable](it Iterator[T], key func(T) K) Iterator[[]T] {
+	return &chunkByIterator[T, K]{
+		key:     key,
+		source:  it,
+		pending: fluent.Empty[T](),
+	}
+}
+
+func (c *chunkByIterator[T, K]) Next() fluent.Option[[]T] {
+	first := c.pending
+	if !first.IsPresent() {
+		first = c.source.Next()
+		if !first.IsPresent() {
+			return fluent.Empty[[]T]()
+		}
+	}
+	chunk := []T{first.Get()}
+	current := c.key(first.Get())
+	for {
+		o := c.source.Next()
+		if !o.IsPresent() || c.key(o.Get()) != current {
+			c.pending = o
+			break
+		}
+		chunk = append(chunk, o.Get())
+	}
+	return fluent.Present(chunk)
+}
@@ -0,0 +1,132 @@
+package iterator
+
+import (
+	"testing"
+
+	"github.com/mikhasd/fluent"
+	"github.com/stretchr/testify/assert"
+)
+
+var chunkTestData = []int{1, 2, 3, 4, 5, 6, 7}
+
+func collect[T any](it Iterator[T]) []T {
+	var out []T
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		out = append(out, o.Get())
+	}
+	return out
+}
+
+func Test_Chunk(t *testing.T) {
+	it := Chunk(FromArray(chunkTestData), 3)
+	expected := [][]int{{1, 2, 3}, {4, 5, 6}, {7}}
+
+	assert.Equal(t, expected, collect(it))
+
+	o := it.Next()
+	assert.False(t, o.IsPresent(), "present")
+}
+
+func Test_Chunk_exact(t *testing.T) {
+	it := Chunk(Of(1, 2, 3, 4), 2)
+	expected := [][]int{{1, 2}, {3, 4}}
+
+	assert.Equal(t, expected, collect(it))
+}
+
+func Test_Chunk_empty(t *testing.T) {
+	it := Chunk(FromArray([]int{}), 2)
+
+	assert.Empty(t, collect(it))
+}
+
+func Test_Chunk_invalid(t *testing.T) {
+	assert.Panics(t, func() {
+		Chunk(Of(1, 2), 0)
+	})
+}
+
+func Test_chunkIterator_Size(t *testing.T) {
+	sizes := map[int]int{1: 7, 2: 4, 3: 3, 7: 1, 10: 1}
+	for size, expected := range sizes {
+		o := Size(Chunk(FromArray(chunkTestData), size))
+		assert.True(t, o.IsPresent(), "present")
+		assert.Equal(t, expected, o.Get(), "size %d", size)
+	}
+}
+
+func Test_chunkIterator_Size_unknown(t *testing.T) {
+	it := Func(func() fluent.Option[int] {
+		return fluent.Empty[int]()
+	})
+	o := Size(Chunk(it, 2))
+	assert.False(t, o.IsPresent(), "present")
+}
+
+func Test_Window(t *testing.T) {
+	it := Window(FromArray(chunkTestData), 3, 1)
+	expected := [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}, {4, 5, 6}, {5, 6, 7}}
+
+	assert.Equal(t, expected, collect(it))
+}
+
+func Test_Window_step(t *testing.T) {
+	it := Window(FromArray(chunkTestData), 3, 2)
+	expected := [][]int{{1, 2, 3}, {3, 4, 5}, {5, 6, 7}}
+
+	assert.Equal(t, expected, collect(it))
+}
+
+func Test_Window_gap(t *testing.T) {
+	it := Window(FromArray(chunkTestData), 2, 3)
+	expected := [][]int{{1, 2}, {4, 5}}
+
+	assert.Equal(t, expected, collect(it))
+}
+
+func Test_Window_short(t *testing.T) {
+	it := Window(Of(1, 2), 3, 1)
+
+	assert.Empty(t, collect(it))
+}
+
+func Test_Window_invalid(t *testing.T) {
+	assert.Panics(t, func() {
+		Window(Of(1, 2), 0, 1)
+	})
+	assert.Panics(t, func() {
+		Window(Of(1, 2), 1, 0)
+	})
+}
+
+func Test_windowIterator_Size(t *testing.T) {
+	cases := [][3]int{{3, 1, 5}, {3, 2, 3}, {2, 3, 2}, {7, 1, 1}, {8, 1, 0}}
+	for _, c := range cases {
+		it := Window(FromArray(chunkTestData), c[0], c[1])
+		o := Size(it)
+		assert.True(t, o.IsPresent(), "present")
+		assert.Equal(t, c[2], o.Get(), "size %d step %d", c[0], c[1])
+		assert.Len(t, collect(it), c[2], "elements")
+	}
+}
+
+func Test_ChunkBy(t *testing.T) {
+	data := []string{"a", "ab", "abc", "b", "bc", "a", "c"}
+	it := ChunkBy(FromArray(data), func(s string) byte {
+		return s[0]
+	})
+	expected := [][]string{{"a", "ab", "abc"}, {"b", "bc"}, {"a"}, {"c"}}
+
+	assert.Equal(t, expected, collect(it))
+
+	o := it.Next()
+	assert.False(t, o.IsPresent(), "present")
+}
+
+func Test_chunkByIterator_Size(t *testing.T) {
+	it := ChunkBy(FromArray(chunkTestData), func(i int) int {
+		return i
+	})
+	o := Size(it)
+	assert.False(t, o.IsPresent(), "present")
+}
@@ -0,0 +1,110 @@
+package iterator
+
+import (
+	"container/heap"
+
+	"github.com/mikhasd/fluent"
+)
+
+// mergeHead is the next element of one of the merged iterators.
+type mergeHead[T any] struct {
+	value  T
+	source int
+}
+
+// mergeHeap is a min-heap of the next element of each merged iterator. Equal
+// elements are ordered by the position of their iterator, so the merge is
+// stable.
+type mergeHeap[T any] struct {
+	heads []mergeHead[T]
+	less  func(a, b T) bool
+}
+
+func (h *mergeHeap[T]) Len() int {
+	return len(h.heads)
+}
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	a, b := h.heads[i], h.heads[j]
+	if h.less(a.value, b.value) {
+		return true
+	}
+	if h.less(b.value, a.value) {
+		return false
+	}
+	return a.source < b.source
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) {
+	h.heads[i], h.heads[j] = h.heads[j], h.heads[i]
+}
+
+func (h *mergeHeap[T]) Push(x any) {
+	h.heads = append(h.heads, x.(mergeHead[T]))
+}
+
+func (h *mergeHeap[T]) Pop() any {
+	last := len(h.heads) - 1
+	head := h.heads[last]
+	h.heads = h.heads[:last]
+	return head
+}
+
+type mergeIterator[T any] struct {
+	sources []Iterator[T]
+	heap    *mergeHeap[T]
+	started bool
+}
+
+// MergeSorted creates an `Iterator` merging the elements of the source
+// iterators, each sorted according to the `less` function, into a single
+// sorted sequence. Equal elements are emitted in the order of their
+// iterators.
+//
+// The sources are consumed lazily, holding a single element of each one at a
+// time, so arbitrarily large sorted sequences can be merged.
+func MergeSorted[T any](less func(a, b T) bool, its ...Iterator[T]) Iterator[T] {
+	return &mergeIterator[T]{
+		sources: its,
+		heap: &mergeHeap[T]{
+			heads: make([]mergeHead[T], 0, len(its)),
+			less:  less,
+		},
+	}
+}
+
+func (m *mergeIterator[T]) Next() fluent.Option[T] {
+	if !m.started {
+		m.started = true
+		for i, it := range m.sources {
+			if o := it.Next(); o.IsPresent() {
+				m.heap.heads = append(m.heap.heads, mergeHead[T]{o.Get(), i})
+			}
+		}
+		heap.Init(m.heap)
+	}
+	if m.heap.Len() == 0 {
+		return fluent.Empty[T]()
+	}
+	head := m.heap.heads[0]
+	if o := m.sources[head.source].Next(); o.IsPresent() {
+		m.heap.heads[0].value = o.Get()
+		heap.Fix(m.heap, 0)
+	} else {
+		heap.Pop(m.heap)
+	}
+	return fluent.Present(head.value)
+}
+
+// Implements iterator.Sized interface
+func (m *mergeIterator[T]) Size() fluent.Option[int] {
+	total := 0
+	for _, it := range m.sources {
+		size := Size(it)
+		if !size.IsPresent() {
+			return size
+		}
+		total += size.Get()
+	}
+	return fluent.Present(total)
+}
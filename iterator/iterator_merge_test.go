@@ -0,0 +1,79 @@
+package iterator
+
+import (
+	"testing"
+
+	"github.com/mikhasd/fluent"
+	"github.com/stretchr/testify/assert"
+)
+
+func lessInts(a, b int) bool {
+	return a < b
+}
+
+func Test_MergeSorted(t *testing.T) {
+	it := MergeSorted(lessInts,
+		Of(1, 4, 7, 10),
+		Of(2, 5, 8),
+		FromArray([]int{}),
+		Of(3, 6, 9),
+	)
+	expected := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	assert.Equal(t, expected, collect(it))
+	assert.False(t, it.Next().IsPresent(), "exhausted")
+}
+
+func Test_MergeSorted_stable(t *testing.T) {
+	type item struct {
+		key    int
+		source string
+	}
+	less := func(a, b item) bool {
+		return a.key < b.key
+	}
+	it := MergeSorted(less,
+		Of(item{1, "a"}, item{2, "a"}),
+		Of(item{1, "b"}, item{2, "b"}),
+		Of(item{1, "c"}),
+	)
+	expected := []item{{1, "a"}, {1, "b"}, {1, "c"}, {2, "a"}, {2, "b"}}
+
+	assert.Equal(t, expected, collect(it))
+}
+
+func Test_MergeSorted_none(t *testing.T) {
+	it := MergeSorted(lessInts)
+
+	assert.Empty(t, collect(it))
+}
+
+func Test_MergeSorted_lazy(t *testing.T) {
+	pulled := 0
+	counting := func(values ...int) Iterator[int] {
+		source := FromArray(values)
+		return Func(func() fluent.Option[int] {
+			o := source.Next()
+			if o.IsPresent() {
+				pulled++
+			}
+			return o
+		})
+	}
+	it := MergeSorted(lessInts, counting(1, 3, 5), counting(2, 4, 6))
+	it.Next()
+
+	assert.Equal(t, 3, pulled, "one element ahead per source")
+}
+
+func Test_mergeIterator_Size(t *testing.T) {
+	it := MergeSorted(lessInts, Of(1, 2), Of(3))
+	o := Size(it)
+	assert.True(t, o.IsPresent(), "present")
+	assert.Equal(t, 3, o.Get())
+
+	it = MergeSorted(lessInts, Of(1), Func(func() fluent.Option[int] {
+		return fluent.Empty[int]()
+	}))
+	assert.False(t, Size(it).IsPresent(), "unknown")
+}
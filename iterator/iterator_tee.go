@@ -0,0 +1,126 @@
+package iterator
+
+import (
+	"sync"
+
+	"github.com/mikhasd/fluent"
+)
+
+type teeBuffer[T any] struct {
+	lock      sync.Mutex
+	cond      *sync.Cond
+	capacity  int
+	buffer    []T
+	offset    int
+	positions []int
+	done      bool
+	source    Iterator[T]
+}
+
+type teeIterator[T any] struct {
+	index  int
+	buffer *teeBuffer[T]
+}
+
+// Tee creates `n` independent iterators over the elements of the source
+// iterator. The source must not be used after calling Tee.
+//
+// Elements are pulled from the source once and kept in a shared buffer until
+// every iterator has read them, so the buffer grows as much as the distance
+// between the leading and the lagging iterators.
+//
+// The iterators are safe to be consumed from different goroutines.
+func Tee[T any](it Iterator[T], n int) []Iterator[T] {
+	return newTee(it, n, 0)
+}
+
+// TeeBounded creates `n` independent iterators over the elements of the
+// source iterator, buffering at most `capacity` elements.
+//
+// Once the buffer is full, the leading iterators block until the lagging
+// iterators catch up, therefore the iterators must be consumed from different
+// goroutines.
+func TeeBounded[T any](it Iterator[T], n, capacity int) []Iterator[T] {
+	if capacity <= 0 {
+		panic("tee capacity must be positive")
+	}
+	return newTee(it, n, capacity)
+}
+
+func newTee[T any](it Iterator[T], n, capacity int) []Iterator[T] {
+	if n <= 0 {
+		panic("tee count must be positive")
+	}
+	buffer := &teeBuffer[T]{
+		capacity:  capacity,
+		positions: make([]int, n),
+		source:    it,
+	}
+	buffer.cond = sync.NewCond(&buffer.lock)
+	iterators := make([]Iterator[T], n)
+	for i := range iterators {
+		iterators[i] = &teeIterator[T]{
+			index:  i,
+			buffer: buffer,
+		}
+	}
+	return iterators
+}
+
+func (t *teeIterator[T]) Next() fluent.Option[T] {
+	return t.buffer.next(t.index)
+}
+
+// Implements iterator.Sized interface
+func (t *teeIterator[T]) Size() fluent.Option[int] {
+	return Size(t.buffer.source)
+}
+
+func (b *teeBuffer[T]) next(index int) fluent.Option[T] {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for {
+		position := b.positions[index]
+		if position < b.offset+len(b.buffer) {
+			value := b.buffer[position-b.offset]
+			b.positions[index]++
+			b.release()
+			return fluent.Present(value)
+		}
+		if b.done {
+			return fluent.Empty[T]()
+		}
+		if b.capacity > 0 && len(b.buffer) >= b.capacity {
+			b.cond.Wait()
+			continue
+		}
+		o := b.source.Next()
+		if !o.IsPresent() {
+			b.done = true
+			b.cond.Broadcast()
+			return o
+		}
+		b.buffer = append(b.buffer, o.Get())
+	}
+}
+
+// release discards the buffered elements already read by every iterator.
+func (b *teeBuffer[T]) release() {
+	lowest := b.positions[0]
+	for _, position := range b.positions[1:] {
+		if position < lowest {
+			lowest = position
+		}
+	}
+	count := lowest - b.offset
+	if count == 0 {
+		return
+	}
+	var zero T
+	for i := 0; i < count; i++ {
+		b.buffer[i] = zero
+	}
+	b.buffer = b.buffer[count:]
+	b.offset = lowest
+	b.cond.Broadcast()
+}
@@ -0,0 +1,87 @@
+package iterator
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Tee(t *testing.T) {
+	iterators := Tee(FromArray(arrayTestData), 3)
+	assert.Len(t, iterators, 3)
+
+	first := iterators[0]
+	first.Next()
+	first.Next()
+
+	assert.Equal(t, arrayTestData, collect(iterators[1]), "second")
+	assert.Equal(t, arrayTestData[2:], collect(first), "first")
+	assert.Equal(t, arrayTestData, collect(iterators[2]), "third")
+
+	o := first.Next()
+	assert.False(t, o.IsPresent(), "present")
+}
+
+func Test_Tee_release(t *testing.T) {
+	iterators := Tee(FromArray(arrayTestData), 2)
+	buffer := iterators[0].(*teeIterator[int]).buffer
+
+	collect(iterators[0])
+	assert.Len(t, buffer.buffer, len(arrayTestData), "buffered")
+
+	iterators[1].Next()
+	iterators[1].Next()
+	assert.Len(t, buffer.buffer, len(arrayTestData)-2, "released")
+	assert.Equal(t, 2, buffer.offset, "offset")
+}
+
+func Test_Tee_invalid(t *testing.T) {
+	assert.Panics(t, func() {
+		Tee(Of(1, 2), 0)
+	})
+}
+
+func Test_teeIterator_Size(t *testing.T) {
+	iterators := Tee(FromArray(arrayTestData), 2)
+	o := Size(iterators[1])
+
+	assert.True(t, o.IsPresent(), "present")
+	assert.Equal(t, len(arrayTestData), o.Get())
+}
+
+func Test_TeeBounded(t *testing.T) {
+	const consumers = 4
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = i
+	}
+	iterators := TeeBounded(FromArray(data), consumers, 8)
+	buffer := iterators[0].(*teeIterator[int]).buffer
+
+	var wg sync.WaitGroup
+	results := make([][]int, consumers)
+	for i, it := range iterators {
+		wg.Add(1)
+		go func(i int, it Iterator[int]) {
+			defer wg.Done()
+			for o := it.Next(); o.IsPresent(); o = it.Next() {
+				buffer.lock.Lock()
+				assert.LessOrEqual(t, len(buffer.buffer), 8, "capacity")
+				buffer.lock.Unlock()
+				results[i] = append(results[i], o.Get())
+			}
+		}(i, it)
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		assert.Equal(t, data, result)
+	}
+}
+
+func Test_TeeBounded_invalid(t *testing.T) {
+	assert.Panics(t, func() {
+		TeeBounded(Of(1, 2), 2, 0)
+	})
+}
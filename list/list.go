@@ -0,0 +1,191 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/stream"
+)
+
+// List is an immutable singly linked list. Prepending an element returns a new
+// list sharing every element of the original list, so a list can be the tail
+// of many other lists.
+//
+// The nil *List is the empty list, and every method can be called on it.
+type List[T any] struct {
+	head T
+	tail *List[T]
+	size int
+}
+
+// Empty returns the empty list.
+func Empty[T any]() *List[T] {
+	return nil
+}
+
+// Cons returns a list with the `head` element followed by the elements of the
+// `tail` list.
+func Cons[T any](head T, tail *List[T]) *List[T] {
+	return &List[T]{
+		head: head,
+		tail: tail,
+		size: tail.Len() + 1,
+	}
+}
+
+// Of returns a list with the given elements, in the same order.
+func Of[T any](elements ...T) *List[T] {
+	var l *List[T]
+	for i := len(elements) - 1; i >= 0; i-- {
+		l = Cons(elements[i], l)
+	}
+	return l
+}
+
+// FromIterable returns a list with the elements of the iterable, in iteration
+// order.
+func FromIterable[T any](iter iterator.Iterable[T]) *List[T] {
+	var reversed *List[T]
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		reversed = Cons(o.Get(), reversed)
+	}
+	return reversed.Reverse()
+}
+
+// Head returns the first element of the list, if any.
+func (l *List[T]) Head() fluent.Option[T] {
+	if l == nil {
+		return fluent.Empty[T]()
+	}
+	return fluent.Present(l.head)
+}
+
+// Tail returns the list without its first element. The tail of the empty list
+// is the empty list.
+func (l *List[T]) Tail() *List[T] {
+	if l == nil {
+		return nil
+	}
+	return l.tail
+}
+
+// Prepend returns a list with the element followed by the elements of this
+// list.
+func (l *List[T]) Prepend(element T) *List[T] {
+	return Cons(element, l)
+}
+
+// Reverse returns a list with the elements of this list in reverse order.
+func (l *List[T]) Reverse() *List[T] {
+	var reversed *List[T]
+	for n := l; n != nil; n = n.tail {
+		reversed = Cons(n.head, reversed)
+	}
+	return reversed
+}
+
+// IsEmpty returns true if the list has no elements.
+func (l *List[T]) IsEmpty() bool {
+	return l == nil
+}
+
+// Len returns the number of elements of the list.
+func (l *List[T]) Len() int {
+	if l == nil {
+		return 0
+	}
+	return l.size
+}
+
+// Implements iterator.Sized interface
+func (l *List[T]) Size() fluent.Option[int] {
+	return fluent.Present(l.Len())
+}
+
+// Iterator returns an iterator over the elements of the list.
+func (l *List[T]) Iterator() iterator.Iterator[T] {
+	return &listIterator[T]{
+		next: l,
+		size: l.Len(),
+	}
+}
+
+// ForEach calls `fn` with each element of the list, in order.
+func (l *List[T]) ForEach(fn func(T)) {
+	for n := l; n != nil; n = n.tail {
+		fn(n.head)
+	}
+}
+
+// ToSlice returns a new array with the elements of the list.
+func (l *List[T]) ToSlice() []T {
+	out := make([]T, 0, l.Len())
+	l.ForEach(func(el T) {
+		out = append(out, el)
+	})
+	return out
+}
+
+// Stream returns a stream with the elements of the list as data source.
+func (l *List[T]) Stream() stream.Stream[T] {
+	return stream.FromIterable[T](l)
+}
+
+func (l *List[T]) String() string {
+	return fmt.Sprintf("List%+v", l.ToSlice())
+}
+
+// FoldLeft combines the elements of the list from the first to the last,
+// calling `fn` with the result of the previous call, starting with `initial`,
+// and the next element.
+func FoldLeft[T any, R any](l *List[T], initial R, fn func(R, T) R) R {
+	acc := initial
+	for n := l; n != nil; n = n.tail {
+		acc = fn(acc, n.head)
+	}
+	return acc
+}
+
+// FoldRight combines the elements of the list from the last to the first,
+// calling `fn` with the next element and the result of the previous call,
+// starting with `initial`.
+//
+// FoldRight is not recursive, so it can fold lists of any length.
+func FoldRight[T any, R any](l *List[T], initial R, fn func(T, R) R) R {
+	acc := initial
+	for n := l.Reverse(); n != nil; n = n.tail {
+		acc = fn(n.head, acc)
+	}
+	return acc
+}
+
+// Map returns a list with the results of applying the `mapper` function to the
+// elements of the list.
+func Map[T any, R any](l *List[T], mapper func(T) R) *List[R] {
+	return FoldRight(l, Empty[R](), func(el T, acc *List[R]) *List[R] {
+		return Cons(mapper(el), acc)
+	})
+}
+
+// List Iterator
+
+type listIterator[T any] struct {
+	next *List[T]
+	size int
+}
+
+func (it *listIterator[T]) Next() fluent.Option[T] {
+	if it.next == nil {
+		return fluent.Empty[T]()
+	}
+	head := it.next.head
+	it.next = it.next.tail
+	return fluent.Present(head)
+}
+
+// Implements iterator.Sized interface
+func (it *listIterator[T]) Size() fluent.Option[int] {
+	return fluent.Present(it.size)
+}
@@ -0,0 +1,128 @@
+package list
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ iterator.Iterable[int] = Of[int]()
+	_ iterator.Sized         = Of[int]()
+)
+
+func Test_Empty(t *testing.T) {
+	l := Empty[int]()
+
+	assert.True(t, l.IsEmpty(), "empty")
+	assert.Equal(t, 0, l.Len(), "len")
+	assert.False(t, l.Head().IsPresent(), "head")
+	assert.True(t, l.Tail().IsEmpty(), "tail")
+	assert.Equal(t, "List[]", l.String())
+}
+
+func Test_Cons(t *testing.T) {
+	l := Cons(1, Cons(2, Empty[int]()))
+
+	assert.Equal(t, 1, l.Head().Get(), "head")
+	assert.Equal(t, 2, l.Tail().Head().Get(), "tail")
+	assert.Equal(t, 2, l.Len(), "len")
+	assert.Equal(t, []int{1, 2}, l.ToSlice())
+}
+
+func Test_Of(t *testing.T) {
+	l := Of(1, 2, 3)
+
+	assert.Equal(t, []int{1, 2, 3}, l.ToSlice())
+	assert.Equal(t, "List[1 2 3]", l.String())
+}
+
+func Test_FromIterable(t *testing.T) {
+	l := FromIterable(iterator.ArrayIterable([]string{"a", "b"}))
+
+	assert.Equal(t, []string{"a", "b"}, l.ToSlice())
+}
+
+func Test_List_Prepend(t *testing.T) {
+	base := Of(2, 3)
+	a := base.Prepend(1)
+	b := base.Prepend(0)
+
+	assert.Equal(t, []int{1, 2, 3}, a.ToSlice())
+	assert.Equal(t, []int{0, 2, 3}, b.ToSlice())
+	assert.Same(t, base, a.Tail(), "shared")
+	assert.Same(t, a.Tail(), b.Tail(), "shared")
+	assert.Equal(t, []int{2, 3}, base.ToSlice(), "unchanged")
+}
+
+func Test_List_Reverse(t *testing.T) {
+	l := Of(1, 2, 3)
+
+	assert.Equal(t, []int{3, 2, 1}, l.Reverse().ToSlice())
+	assert.Equal(t, []int{1, 2, 3}, l.ToSlice(), "unchanged")
+	assert.True(t, Empty[int]().Reverse().IsEmpty(), "empty")
+}
+
+func Test_List_Iterator(t *testing.T) {
+	l := Of(1, 2, 3)
+	it := l.Iterator()
+
+	size := iterator.Size(it)
+	assert.True(t, size.IsPresent(), "sized")
+	assert.Equal(t, 3, size.Get())
+
+	var actual []int
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		actual = append(actual, o.Get())
+	}
+	assert.Equal(t, []int{1, 2, 3}, actual)
+}
+
+func Test_List_Size(t *testing.T) {
+	assert.Equal(t, 3, Of(1, 2, 3).Size().Get())
+	assert.Equal(t, 0, Empty[int]().Size().Get())
+}
+
+func Test_List_Stream(t *testing.T) {
+	actual := Of(1, 2, 3, 4).Stream().Filter(func(i int) bool {
+		return i%2 == 0
+	}).Array()
+
+	assert.Equal(t, []int{2, 4}, actual)
+}
+
+func Test_FoldLeft(t *testing.T) {
+	actual := FoldLeft(Of(1, 2, 3), "", func(acc string, i int) string {
+		return acc + strconv.Itoa(i)
+	})
+
+	assert.Equal(t, "123", actual)
+}
+
+func Test_FoldRight(t *testing.T) {
+	actual := FoldRight(Of(1, 2, 3), "", func(i int, acc string) string {
+		return acc + strconv.Itoa(i)
+	})
+
+	assert.Equal(t, "321", actual)
+}
+
+func Test_FoldRight_long(t *testing.T) {
+	var l *List[int]
+	for i := 0; i < 1000000; i++ {
+		l = l.Prepend(1)
+	}
+	sum := FoldRight(l, 0, func(i, acc int) int {
+		return acc + i
+	})
+
+	assert.Equal(t, 1000000, sum)
+}
+
+func Test_Map(t *testing.T) {
+	l := Map(Of(1, 2, 3), strconv.Itoa)
+
+	assert.Equal(t, []string{"1", "2", "3"}, l.ToSlice())
+}
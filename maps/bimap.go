@@ -0,0 +1,217 @@
+package maps
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+)
+
+// BiMap associates keys with values which are unique as well as its keys, so
+// it can be looked up in both directions.
+//
+// A BiMap is a ReadOnly map but not a Map: associating a value already
+// associated with another key is a conflict, which its Put, ComputeIfAbsent
+// and Merge methods report instead of overwriting the other key. ForcePut
+// overwrites it.
+//
+// The iteration order of a BiMap is unspecified.
+type BiMap[K comparable, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+	inverse  *BiMap[V, K]
+}
+
+// GetByKey returns the value associated with the key, if any.
+func (m *BiMap[K, V]) GetByKey(key K) fluent.Option[V] {
+	if value, found := m.forward[key]; found {
+		return fluent.Present(value)
+	}
+	return fluent.Empty[V]()
+}
+
+// GetByValue returns the key associated with the value, if any.
+func (m *BiMap[K, V]) GetByValue(value V) fluent.Option[K] {
+	if key, found := m.backward[value]; found {
+		return fluent.Present(key)
+	}
+	return fluent.Empty[K]()
+}
+
+// Get is equivalent to GetByKey.
+func (m *BiMap[K, V]) Get(key K) fluent.Option[V] {
+	return m.GetByKey(key)
+}
+
+func (m *BiMap[K, V]) ContainsKey(key K) bool {
+	_, found := m.forward[key]
+	return found
+}
+
+// ContainsValue returns true if a key is associated with the value.
+func (m *BiMap[K, V]) ContainsValue(value V) bool {
+	_, found := m.backward[value]
+	return found
+}
+
+// Put associates the value with the key, returning the value previously
+// associated with the key, if any, and true. If the value is already
+// associated with another key, Put leaves the map unchanged and returns false;
+// use ForcePut to replace that association.
+func (m *BiMap[K, V]) Put(key K, value V) (fluent.Option[V], bool) {
+	if m.conflicts(key, value) {
+		return fluent.Empty[V](), false
+	}
+	return m.put(key, value), true
+}
+
+// conflicts returns true if the value is associated with a key other than
+// `key`.
+func (m *BiMap[K, V]) conflicts(key K, value V) bool {
+	current, found := m.backward[value]
+	return found && current != key
+}
+
+// ForcePut associates the value with the key, removing the key previously
+// associated with the value, if any. ForcePut returns the value previously
+// associated with the key, if any.
+func (m *BiMap[K, V]) ForcePut(key K, value V) fluent.Option[V] {
+	if current, found := m.backward[value]; found && current != key {
+		delete(m.forward, current)
+	}
+	return m.put(key, value)
+}
+
+func (m *BiMap[K, V]) put(key K, value V) fluent.Option[V] {
+	previous := m.GetByKey(key)
+	if previous.IsPresent() {
+		delete(m.backward, previous.Get())
+	}
+	m.forward[key] = value
+	m.backward[value] = key
+	return previous
+}
+
+// Remove removes the key, returning the value associated with it, if any.
+func (m *BiMap[K, V]) Remove(key K) fluent.Option[V] {
+	value, found := m.forward[key]
+	if !found {
+		return fluent.Empty[V]()
+	}
+	delete(m.forward, key)
+	delete(m.backward, value)
+	return fluent.Present(value)
+}
+
+// RemoveByValue removes the value, returning the key associated with it, if
+// any.
+func (m *BiMap[K, V]) RemoveByValue(value V) fluent.Option[K] {
+	return m.Inverse().Remove(value)
+}
+
+func (m *BiMap[K, V]) Clear() {
+	for key := range m.forward {
+		delete(m.forward, key)
+	}
+	for value := range m.backward {
+		delete(m.backward, value)
+	}
+}
+
+// ComputeIfAbsent returns the value associated with the key and true. If the
+// key is absent, it is first associated with the result of `compute`, unless
+// that value is already associated with another key: the map is then left
+// unchanged, and the computed value is returned along with false.
+func (m *BiMap[K, V]) ComputeIfAbsent(key K, compute func(K) V) (V, bool) {
+	if value, found := m.forward[key]; found {
+		return value, true
+	}
+	value := compute(key)
+	_, ok := m.Put(key, value)
+	return value, ok
+}
+
+// Merge associates the key with the value if the key is absent, or with the
+// result of `merge` applied to the current value and the value otherwise.
+// Merge returns the value now associated with the key and true, unless that
+// value is already associated with another key: the map is then left
+// unchanged, and the rejected value is returned along with false.
+func (m *BiMap[K, V]) Merge(key K, value V, merge func(current, value V) V) (V, bool) {
+	if current, found := m.forward[key]; found {
+		value = merge(current, value)
+	}
+	_, ok := m.Put(key, value)
+	return value, ok
+}
+
+// Inverse returns a view of the map with keys and values swapped. The view
+// shares its entries with this map, so changes to either are visible in both.
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	if m.inverse == nil {
+		m.inverse = &BiMap[V, K]{
+			forward:  m.backward,
+			backward: m.forward,
+			inverse:  m,
+		}
+	}
+	return m.inverse
+}
+
+func (m *BiMap[K, V]) Empty() bool {
+	return len(m.forward) == 0
+}
+
+func (m *BiMap[K, V]) Size() int {
+	return len(m.forward)
+}
+
+func (m *BiMap[K, V]) Keys() iterator.Iterator[K] {
+	return iterator.MapKeys(m.forward)
+}
+
+func (m *BiMap[K, V]) Values() iterator.Iterator[V] {
+	return iterator.MapKeys(m.backward)
+}
+
+func (m *BiMap[K, V]) Entries() iterator.Iterator[iterator.MapEntry[K, V]] {
+	return iterator.FromMap(m.forward)
+}
+
+func (m *BiMap[K, V]) ForEach(fn func(K, V)) {
+	for key, value := range m.forward {
+		fn(key, value)
+	}
+}
+
+// String lists the entries of the map sorted by key, as formatted by the fmt
+// package.
+func (m *BiMap[K, V]) String() string {
+	return "BiMap" + strings.TrimPrefix(fmt.Sprint(m.forward), "map")
+}
+
+// NewBiMap creates an empty BiMap.
+func NewBiMap[K comparable, V comparable]() *BiMap[K, V] {
+	return BiMapWithSize[K, V](16)
+}
+
+// BiMapWithSize creates an empty BiMap with room for `size` entries.
+func BiMapWithSize[K comparable, V comparable](size int) *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward:  make(map[K]V, size),
+		backward: make(map[V]K, size),
+	}
+}
+
+// BiMapFromIterable creates a BiMap with the entries of the iterable. Later
+// entries replace the associations of earlier entries with the same key or
+// value.
+func BiMapFromIterable[K comparable, V comparable](iter iterator.Iterable[iterator.MapEntry[K, V]]) *BiMap[K, V] {
+	it := iter.Iterator()
+	m := BiMapWithSize[K, V](iterator.Size(it).OrElse(16))
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		e := o.Get()
+		m.ForcePut(e.Key, e.Value)
+	}
+	return m
+}
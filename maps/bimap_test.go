@@ -0,0 +1,137 @@
+package maps
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ ReadOnly[string, int] = NewBiMap[string, int]()
+
+func Test_BiMap_GetByKey_GetByValue(t *testing.T) {
+	m := NewBiMap[string, int]()
+	m.Put("one", 1)
+	m.Put("two", 2)
+
+	assert.Equal(t, 1, m.GetByKey("one").Get())
+	assert.Equal(t, "two", m.GetByValue(2).Get())
+	assert.False(t, m.GetByKey("three").IsPresent(), "absent key")
+	assert.False(t, m.GetByValue(3).IsPresent(), "absent value")
+	assert.True(t, m.ContainsValue(1))
+}
+
+func Test_BiMap_Put_uniqueValues(t *testing.T) {
+	m := NewBiMap[string, int]()
+	m.Put("one", 1)
+
+	previous, ok := m.Put("uno", 1)
+	assert.False(t, ok, "value bound to another key")
+	assert.False(t, previous.IsPresent())
+	assert.False(t, m.ContainsKey("uno"), "unchanged")
+	assert.Equal(t, "one", m.GetByValue(1).Get(), "unchanged")
+
+	previous, ok = m.Put("one", 1)
+	assert.True(t, ok, "same association")
+	assert.Equal(t, 1, previous.Get())
+
+	previous, ok = m.Put("one", 10)
+	assert.True(t, ok)
+	assert.Equal(t, 1, previous.Get(), "replaced value")
+	assert.False(t, m.ContainsValue(1), "previous value released")
+	assert.Equal(t, "one", m.GetByValue(10).Get())
+	assert.Equal(t, 1, m.Size())
+}
+
+func Test_BiMap_ForcePut(t *testing.T) {
+	m := NewBiMap[string, int]()
+	m.Put("one", 1)
+	m.Put("two", 2)
+
+	assert.Equal(t, 2, m.ForcePut("two", 1).Get())
+	assert.False(t, m.ContainsKey("one"), "previous key removed")
+	assert.False(t, m.ContainsValue(2), "previous value removed")
+	assert.Equal(t, "two", m.GetByValue(1).Get())
+	assert.Equal(t, 1, m.Size())
+}
+
+func Test_BiMap_Inverse(t *testing.T) {
+	m := NewBiMap[string, int]()
+	m.Put("one", 1)
+	inverse := m.Inverse()
+
+	assert.Equal(t, "one", inverse.GetByKey(1).Get())
+	assert.Same(t, m, inverse.Inverse(), "inverse of inverse")
+	assert.Same(t, inverse, m.Inverse(), "cached")
+
+	inverse.Put(2, "two")
+	assert.Equal(t, 2, m.GetByKey("two").Get(), "put through view")
+
+	m.Remove("one")
+	assert.False(t, inverse.ContainsKey(1), "removed through map")
+
+	assert.Equal(t, "two", m.RemoveByValue(2).Get())
+	assert.True(t, inverse.Empty())
+
+	m.Put("three", 3)
+	inverse.Clear()
+	assert.True(t, m.Empty(), "cleared through view")
+}
+
+func Test_BiMap_Entries(t *testing.T) {
+	m := NewBiMap[string, int]()
+	m.Put("b", 2)
+	m.Put("a", 1)
+	entries := drain(m.Entries())
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+
+	assert.Equal(t, []iterator.MapEntry[string, int]{entry("a", 1), entry("b", 2)}, entries)
+	assert.ElementsMatch(t, []int{1, 2}, drain(m.Values()))
+	assert.Equal(t, "BiMap[a:1 b:2]", m.String())
+	assert.Equal(t, "BiMap[1:a 2:b]", m.Inverse().String())
+}
+
+func Test_BiMapFromIterable(t *testing.T) {
+	entries := []iterator.MapEntry[string, int]{entry("a", 1), entry("b", 2), entry("c", 1)}
+	m := BiMapFromIterable[string, int](iterator.ArrayIterable(entries))
+
+	assert.Equal(t, 2, m.Size())
+	assert.Equal(t, "c", m.GetByValue(1).Get())
+	assert.False(t, m.ContainsKey("a"))
+}
+
+func Test_BiMap_ComputeIfAbsent_Merge(t *testing.T) {
+	m := NewBiMap[string, int]()
+	length := func(key string) int {
+		return len(key)
+	}
+	sum := func(current, value int) int {
+		return current + value
+	}
+
+	value, ok := m.ComputeIfAbsent("abc", length)
+	assert.Equal(t, 3, value)
+	assert.True(t, ok, "computed")
+	value, ok = m.ComputeIfAbsent("abc", func(string) int { return 0 })
+	assert.Equal(t, 3, value)
+	assert.True(t, ok, "present")
+	value, ok = m.ComputeIfAbsent("xyz", length)
+	assert.Equal(t, 3, value)
+	assert.False(t, ok, "computed value bound to another key")
+	assert.False(t, m.ContainsKey("xyz"), "not stored")
+
+	value, ok = m.Merge("abc", 4, sum)
+	assert.Equal(t, 7, value)
+	assert.True(t, ok, "merged")
+	assert.Equal(t, "abc", m.GetByValue(7).Get())
+	assert.False(t, m.ContainsValue(3), "merged value replaced")
+
+	value, ok = m.Merge("x", 7, sum)
+	assert.Equal(t, 7, value)
+	assert.False(t, ok, "value bound to another key")
+	assert.False(t, m.ContainsKey("x"), "not stored")
+	assert.Equal(t, 1, m.Size())
+}
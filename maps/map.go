@@ -0,0 +1,111 @@
+package maps
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+)
+
+// ReadOnly is the subset of the Map operations which do not modify the map.
+type ReadOnly[K comparable, V any] interface {
+	// Get returns the value associated with the key, if any.
+	Get(key K) fluent.Option[V]
+	// ContainsKey returns true if a value is associated with the key.
+	ContainsKey(key K) bool
+	Empty() bool
+	// Size returns the number of keys of the map.
+	Size() int
+	Keys() iterator.Iterator[K]
+	Values() iterator.Iterator[V]
+	Entries() iterator.Iterator[iterator.MapEntry[K, V]]
+	ForEach(fn func(K, V))
+	// String returns a representation of the map listing its entries.
+	String() string
+}
+
+// Map associates keys with values. The order in which keys are iterated over
+// depends on the implementation.
+type Map[K comparable, V any] interface {
+	ReadOnly[K, V]
+	// Put associates the value with the key, returning the value previously
+	// associated with it, if any.
+	Put(key K, value V) fluent.Option[V]
+	// Remove removes the key, returning the value associated with it, if any.
+	Remove(key K) fluent.Option[V]
+	// Clear removes every key from the map.
+	Clear()
+	// ComputeIfAbsent returns the value associated with the key. If the key is
+	// absent, it is first associated with the result of `compute`.
+	ComputeIfAbsent(key K, compute func(K) V) V
+	// Merge associates the key with the value if the key is absent, or with
+	// the result of `merge` applied to the current value and the value
+	// otherwise. Merge returns the value now associated with the key.
+	Merge(key K, value V, merge func(current, value V) V) V
+}
+
+func computeIfAbsent[K comparable, V any](m Map[K, V], key K, compute func(K) V) V {
+	if current := m.Get(key); current.IsPresent() {
+		return current.Get()
+	}
+	value := compute(key)
+	m.Put(key, value)
+	return value
+}
+
+func merge[K comparable, V any](m Map[K, V], key K, value V, merge func(current, value V) V) V {
+	if current := m.Get(key); current.IsPresent() {
+		value = merge(current.Get(), value)
+	}
+	m.Put(key, value)
+	return value
+}
+
+func toString[K comparable, V any](name string, m ReadOnly[K, V]) string {
+	var b strings.Builder
+	b.WriteString(name)
+	b.WriteByte('[')
+	first := true
+	m.ForEach(func(key K, value V) {
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		fmt.Fprintf(&b, "%+v:%+v", key, value)
+	})
+	b.WriteByte(']')
+	return b.String()
+}
+
+func entryOf[K comparable, V any](key K, value V) iterator.MapEntry[K, V] {
+	return iterator.MapEntry[K, V]{
+		Key:   key,
+		Value: value,
+	}
+}
+
+// Projected Iterator
+
+// projectedIterator applies the `project` function to the elements of the
+// source iterator, keeping its size.
+type projectedIterator[N any, R any] struct {
+	source  iterator.Iterator[N]
+	project func(N) R
+}
+
+func project[N any, R any](source iterator.Iterator[N], fn func(N) R) iterator.Iterator[R] {
+	return &projectedIterator[N, R]{
+		source:  source,
+		project: fn,
+	}
+}
+
+func (it *projectedIterator[N, R]) Next() fluent.Option[R] {
+	return fluent.MapOption(it.source.Next(), it.project)
+}
+
+// Implements iterator.Sized interface
+func (it *projectedIterator[N, R]) Size() fluent.Option[int] {
+	return iterator.Size(it.source)
+}
@@ -0,0 +1,129 @@
+package maps
+
+import (
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/internal/linked"
+	"github.com/mikhasd/fluent/iterator"
+)
+
+// LinkedMap is a Map which iterates over its keys in the order they were
+// first added. Associating a new value with a present key keeps its position.
+//
+// Keys removed while iterating are never returned by the iteration; keys
+// added while iterating may or may not be returned.
+type LinkedMap[K comparable, V any] interface {
+	Map[K, V]
+
+	// First returns the oldest entry of the map, if any.
+	First() fluent.Option[iterator.MapEntry[K, V]]
+	// Last returns the newest entry of the map, if any.
+	Last() fluent.Option[iterator.MapEntry[K, V]]
+}
+
+type linkedMap[K comparable, V any] struct {
+	items *linked.Map[K, V]
+}
+
+func (m *linkedMap[K, V]) Get(key K) fluent.Option[V] {
+	if node := m.items.Find(key); node != nil {
+		return fluent.Present(node.Value)
+	}
+	return fluent.Empty[V]()
+}
+
+func (m *linkedMap[K, V]) ContainsKey(key K) bool {
+	return m.items.Find(key) != nil
+}
+
+func (m *linkedMap[K, V]) Put(key K, value V) fluent.Option[V] {
+	return m.items.Put(key, value)
+}
+
+func (m *linkedMap[K, V]) Remove(key K) fluent.Option[V] {
+	return m.items.Remove(key)
+}
+
+func (m *linkedMap[K, V]) Clear() {
+	m.items.Clear()
+}
+
+func (m *linkedMap[K, V]) ComputeIfAbsent(key K, compute func(K) V) V {
+	return computeIfAbsent[K, V](m, key, compute)
+}
+
+func (m *linkedMap[K, V]) Merge(key K, value V, fn func(current, value V) V) V {
+	return merge[K, V](m, key, value, fn)
+}
+
+func (m *linkedMap[K, V]) Empty() bool {
+	return m.items.Len() == 0
+}
+
+func (m *linkedMap[K, V]) Size() int {
+	return m.items.Len()
+}
+
+func (m *linkedMap[K, V]) ForEach(fn func(K, V)) {
+	m.items.ForEach(fn)
+}
+
+func (m *linkedMap[K, V]) String() string {
+	return toString[K, V]("Map", m)
+}
+
+func nodeEntry[K comparable, V any](node *linked.Node[K, V]) iterator.MapEntry[K, V] {
+	return entryOf(node.Key, node.Value)
+}
+
+func (m *linkedMap[K, V]) First() fluent.Option[iterator.MapEntry[K, V]] {
+	if node := m.items.First(); node != nil {
+		return fluent.Present(nodeEntry(node))
+	}
+	return fluent.Empty[iterator.MapEntry[K, V]]()
+}
+
+func (m *linkedMap[K, V]) Last() fluent.Option[iterator.MapEntry[K, V]] {
+	if node := m.items.Last(); node != nil {
+		return fluent.Present(nodeEntry(node))
+	}
+	return fluent.Empty[iterator.MapEntry[K, V]]()
+}
+
+func (m *linkedMap[K, V]) Keys() iterator.Iterator[K] {
+	return m.items.Keys()
+}
+
+func (m *linkedMap[K, V]) Values() iterator.Iterator[V] {
+	return project(m.items.Nodes(), func(node *linked.Node[K, V]) V {
+		return node.Value
+	})
+}
+
+func (m *linkedMap[K, V]) Entries() iterator.Iterator[iterator.MapEntry[K, V]] {
+	return project(m.items.Nodes(), nodeEntry[K, V])
+}
+
+// NewLinked creates an empty LinkedMap.
+func NewLinked[K comparable, V any]() LinkedMap[K, V] {
+	return LinkedWithSize[K, V](16)
+}
+
+// LinkedWithSize creates an empty LinkedMap with room for `size` keys.
+func LinkedWithSize[K comparable, V any](size int) LinkedMap[K, V] {
+	return &linkedMap[K, V]{
+		items: linked.New[K, V](size),
+	}
+}
+
+// LinkedFromIterable creates a LinkedMap with the entries of the iterable, in
+// iteration order. Later entries replace the values of earlier entries with
+// the same key.
+func LinkedFromIterable[K comparable, V any](iter iterator.Iterable[iterator.MapEntry[K, V]]) LinkedMap[K, V] {
+	it := iter.Iterator()
+	m := LinkedWithSize[K, V](iterator.Size(it).OrElse(16))
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		e := o.Get()
+		m.Put(e.Key, e.Value)
+	}
+	return m
+}
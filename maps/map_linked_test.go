@@ -0,0 +1,93 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LinkedMap_order(t *testing.T) {
+	m := NewLinked[string, int]()
+	m.Put("c", 1)
+	m.Put("a", 2)
+	m.Put("b", 3)
+	m.Put("c", 4)
+
+	assert.Equal(t, []string{"c", "a", "b"}, drain(m.Keys()))
+	assert.Equal(t, []int{4, 2, 3}, drain(m.Values()))
+	assert.Equal(t, []iterator.MapEntry[string, int]{entry("c", 4), entry("a", 2), entry("b", 3)}, drain(m.Entries()))
+	assert.Equal(t, "Map[c:4 a:2 b:3]", m.String())
+}
+
+func Test_LinkedMap_First_Last(t *testing.T) {
+	m := NewLinked[string, int]()
+	assert.False(t, m.First().IsPresent(), "empty first")
+	assert.False(t, m.Last().IsPresent(), "empty last")
+
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Remove("a")
+
+	assert.Equal(t, entry("b", 2), m.First().Get())
+	assert.Equal(t, entry("c", 3), m.Last().Get())
+}
+
+func Test_LinkedMap_removeWhileIterating(t *testing.T) {
+	m := NewLinked[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	it := m.Keys()
+
+	assert.Equal(t, "a", it.Next().Get())
+	m.Remove("a")
+	m.Remove("c")
+	assert.Equal(t, "b", it.Next().Get())
+	assert.False(t, it.Next().IsPresent())
+}
+
+func Test_LinkedMap_removeNext(t *testing.T) {
+	m := NewLinked[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	it := m.Entries()
+
+	assert.Equal(t, entry("a", 1), it.Next().Get())
+	m.Remove("b")
+	assert.Equal(t, entry("c", 3), it.Next().Get(), "removed entry skipped")
+	assert.False(t, it.Next().IsPresent())
+}
+
+func Test_LinkedFromIterable(t *testing.T) {
+	entries := []iterator.MapEntry[string, int]{entry("b", 1), entry("a", 2), entry("b", 3)}
+	m := LinkedFromIterable[string, int](iterator.ArrayIterable(entries))
+
+	assert.Equal(t, []iterator.MapEntry[string, int]{entry("b", 3), entry("a", 2)}, drain(m.Entries()))
+}
+
+func Test_LinkedMap_Entries_unsized(t *testing.T) {
+	m := NewLinked[string, int]()
+	m.Put("x", 1)
+
+	assert.False(t, iterator.Size(m.Entries()).IsPresent(), "entries")
+	assert.False(t, iterator.Size(m.Keys()).IsPresent(), "keys")
+	assert.False(t, iterator.Size(m.Values()).IsPresent(), "values")
+}
+
+func Test_LinkedMap_Entries_stream(t *testing.T) {
+	m := NewLinked[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	entries := stream.FromIterator(m.Entries()).Peek(func(e iterator.MapEntry[string, int]) {
+		if e.Key == "a" {
+			m.Remove("b")
+		}
+	}).Array()
+
+	assert.Equal(t, []iterator.MapEntry[string, int]{entry("a", 1), entry("c", 3)}, entries)
+}
@@ -0,0 +1,96 @@
+package maps
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+func compareStrings(a, b string) int {
+	return strings.Compare(a, b)
+}
+
+func drain[T any](it iterator.Iterator[T]) []T {
+	out := []T{}
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		out = append(out, o.Get())
+	}
+	return out
+}
+
+func entry(key string, value int) iterator.MapEntry[string, int] {
+	return iterator.MapEntry[string, int]{Key: key, Value: value}
+}
+
+// implementations returns a function creating an empty map for each Map
+// implementation.
+func implementations() map[string]func() Map[string, int] {
+	return map[string]func() Map[string, int]{
+		"linked": func() Map[string, int] { return NewLinked[string, int]() },
+		"tree":   func() Map[string, int] { return NewTree[string, int](compareStrings) },
+	}
+}
+
+func Test_Map_Put_Get(t *testing.T) {
+	for name, create := range implementations() {
+		m := create()
+		assert.False(t, m.Put("a", 1).IsPresent(), "%s: new key", name)
+		assert.Equal(t, 1, m.Put("a", 2).Get(), "%s: replaced", name)
+		assert.Equal(t, 2, m.Get("a").Get(), "%s: get", name)
+		assert.False(t, m.Get("b").IsPresent(), "%s: absent", name)
+		assert.True(t, m.ContainsKey("a"), "%s: contains", name)
+		assert.Equal(t, 1, m.Size(), "%s: size", name)
+	}
+}
+
+func Test_Map_Remove(t *testing.T) {
+	for name, create := range implementations() {
+		m := create()
+		m.Put("a", 1)
+		m.Put("b", 2)
+
+		assert.Equal(t, 1, m.Remove("a").Get(), "%s: removed", name)
+		assert.False(t, m.Remove("a").IsPresent(), "%s: absent", name)
+		assert.False(t, m.ContainsKey("a"), "%s: contains", name)
+		assert.Equal(t, 1, m.Size(), "%s: size", name)
+
+		m.Clear()
+		assert.True(t, m.Empty(), "%s: cleared", name)
+		assert.Empty(t, drain(m.Entries()), "%s: entries", name)
+	}
+}
+
+func Test_Map_ComputeIfAbsent(t *testing.T) {
+	for name, create := range implementations() {
+		m := create()
+		calls := 0
+		compute := func(key string) int {
+			calls++
+			return len(key)
+		}
+
+		assert.Equal(t, 3, m.ComputeIfAbsent("abc", compute), "%s: computed", name)
+		assert.Equal(t, 3, m.ComputeIfAbsent("abc", compute), "%s: present", name)
+		assert.Equal(t, 1, calls, "%s: calls", name)
+		assert.Equal(t, 3, m.Get("abc").Get(), "%s: stored", name)
+	}
+}
+
+func Test_Map_Merge(t *testing.T) {
+	for name, create := range implementations() {
+		m := create()
+		sum := func(current, value int) int {
+			return current + value
+		}
+		for _, word := range strings.Fields("a b a c a b") {
+			m.Merge(word, 1, sum)
+		}
+
+		assert.Equal(t, 3, m.Get("a").Get(), "%s: a", name)
+		assert.Equal(t, 2, m.Get("b").Get(), "%s: b", name)
+		assert.Equal(t, 1, m.Get("c").Get(), "%s: c", name)
+		assert.Equal(t, 4, m.Merge("c", 3, sum), "%s: merged value", name)
+	}
+}
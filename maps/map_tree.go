@@ -0,0 +1,149 @@
+package maps
+
+import (
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/internal/avl"
+	"github.com/mikhasd/fluent/iterator"
+)
+
+// TreeMap is a Map which keeps its keys ordered according to a comparator
+// function, iterating over them in ascending order.
+type TreeMap[K comparable, V any] interface {
+	Map[K, V]
+
+	// First returns the entry with the lowest key, if any.
+	First() fluent.Option[iterator.MapEntry[K, V]]
+	// Last returns the entry with the highest key, if any.
+	Last() fluent.Option[iterator.MapEntry[K, V]]
+	// Floor returns the entry with the greatest key lower than or equal to
+	// `key`, if any.
+	Floor(key K) fluent.Option[iterator.MapEntry[K, V]]
+	// Ceiling returns the entry with the least key greater than or equal to
+	// `key`, if any.
+	Ceiling(key K) fluent.Option[iterator.MapEntry[K, V]]
+	// Range returns an iterator, in ascending order, over the entries of the
+	// map with keys from `from`, inclusive, to `to`, exclusive.
+	Range(from, to K) iterator.Iterator[iterator.MapEntry[K, V]]
+	// Descending returns an iterator over the entries of the map in descending
+	// key order.
+	Descending() iterator.Iterator[iterator.MapEntry[K, V]]
+}
+
+// treeMap is backed by an AVL tree.
+type treeMap[K comparable, V any] struct {
+	tree *avl.Tree[K, V]
+}
+
+func (m *treeMap[K, V]) Get(key K) fluent.Option[V] {
+	return m.tree.Get(key)
+}
+
+func (m *treeMap[K, V]) ContainsKey(key K) bool {
+	return m.tree.Contains(key)
+}
+
+func (m *treeMap[K, V]) Put(key K, value V) fluent.Option[V] {
+	return m.tree.Put(key, value)
+}
+
+func (m *treeMap[K, V]) Remove(key K) fluent.Option[V] {
+	return m.tree.Remove(key)
+}
+
+func (m *treeMap[K, V]) Clear() {
+	m.tree.Clear()
+}
+
+func (m *treeMap[K, V]) ComputeIfAbsent(key K, compute func(K) V) V {
+	return computeIfAbsent[K, V](m, key, compute)
+}
+
+func (m *treeMap[K, V]) Merge(key K, value V, fn func(current, value V) V) V {
+	return merge[K, V](m, key, value, fn)
+}
+
+func (m *treeMap[K, V]) Empty() bool {
+	return m.tree.Len() == 0
+}
+
+func (m *treeMap[K, V]) Size() int {
+	return m.tree.Len()
+}
+
+func (m *treeMap[K, V]) ForEach(fn func(K, V)) {
+	m.tree.ForEach(fn)
+}
+
+func (m *treeMap[K, V]) String() string {
+	return toString[K, V]("Map", m)
+}
+
+func mapEntry[K comparable, V any](e avl.Entry[K, V]) iterator.MapEntry[K, V] {
+	return entryOf(e.Key, e.Value)
+}
+
+func mapEntryOption[K comparable, V any](o fluent.Option[avl.Entry[K, V]]) fluent.Option[iterator.MapEntry[K, V]] {
+	return fluent.MapOption(o, mapEntry[K, V])
+}
+
+func (m *treeMap[K, V]) First() fluent.Option[iterator.MapEntry[K, V]] {
+	return mapEntryOption(m.tree.Min())
+}
+
+func (m *treeMap[K, V]) Last() fluent.Option[iterator.MapEntry[K, V]] {
+	return mapEntryOption(m.tree.Max())
+}
+
+func (m *treeMap[K, V]) Floor(key K) fluent.Option[iterator.MapEntry[K, V]] {
+	return mapEntryOption(m.tree.Floor(key))
+}
+
+func (m *treeMap[K, V]) Ceiling(key K) fluent.Option[iterator.MapEntry[K, V]] {
+	return mapEntryOption(m.tree.Ceiling(key))
+}
+
+func (m *treeMap[K, V]) Keys() iterator.Iterator[K] {
+	return avl.Keys(m.tree.Ascending())
+}
+
+func (m *treeMap[K, V]) Values() iterator.Iterator[V] {
+	return project(m.tree.Ascending(), func(e avl.Entry[K, V]) V {
+		return e.Value
+	})
+}
+
+func (m *treeMap[K, V]) Entries() iterator.Iterator[iterator.MapEntry[K, V]] {
+	return project(m.tree.Ascending(), mapEntry[K, V])
+}
+
+func (m *treeMap[K, V]) Descending() iterator.Iterator[iterator.MapEntry[K, V]] {
+	return project(m.tree.Descending(), mapEntry[K, V])
+}
+
+func (m *treeMap[K, V]) Range(from, to K) iterator.Iterator[iterator.MapEntry[K, V]] {
+	return project(m.tree.Range(from, to), mapEntry[K, V])
+}
+
+// NewTree creates an empty TreeMap ordered by the `compare` function, which
+// must return a negative number if `a` is lower than `b`, zero if they are
+// equal and a positive number if `a` is greater than `b`.
+//
+// Keys for which `compare` returns zero are considered the same key.
+func NewTree[K comparable, V any](compare func(a, b K) int) TreeMap[K, V] {
+	return &treeMap[K, V]{
+		tree: avl.New[K, V](compare),
+	}
+}
+
+// TreeFromIterable creates a TreeMap ordered by the `compare` function with
+// the entries of the iterable. Later entries replace the values of earlier
+// entries with the same key.
+func TreeFromIterable[K comparable, V any](iter iterator.Iterable[iterator.MapEntry[K, V]], compare func(a, b K) int) TreeMap[K, V] {
+	m := NewTree[K, V](compare)
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		e := o.Get()
+		m.Put(e.Key, e.Value)
+	}
+	return m
+}
@@ -0,0 +1,112 @@
+package maps
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+func compareInts(a, b int) int {
+	return a - b
+}
+
+func Test_TreeMap_order(t *testing.T) {
+	m := NewTree[string, int](compareStrings)
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	assert.Equal(t, []string{"a", "b", "c"}, drain(m.Keys()))
+	assert.Equal(t, []int{1, 2, 3}, drain(m.Values()))
+	assert.Equal(t, []iterator.MapEntry[string, int]{entry("c", 3), entry("b", 2), entry("a", 1)}, drain(m.Descending()))
+	assert.Equal(t, "Map[a:1 b:2 c:3]", m.String())
+}
+
+func Test_TreeMap_navigation(t *testing.T) {
+	m := NewTree[string, int](compareStrings)
+	assert.False(t, m.First().IsPresent(), "empty first")
+	assert.False(t, m.Last().IsPresent(), "empty last")
+
+	m.Put("b", 2)
+	m.Put("d", 4)
+	m.Put("f", 6)
+
+	assert.Equal(t, entry("b", 2), m.First().Get(), "first")
+	assert.Equal(t, entry("f", 6), m.Last().Get(), "last")
+	assert.Equal(t, entry("d", 4), m.Floor("e").Get(), "floor")
+	assert.Equal(t, entry("d", 4), m.Floor("d").Get(), "floor equal")
+	assert.False(t, m.Floor("a").IsPresent(), "no floor")
+	assert.Equal(t, entry("f", 6), m.Ceiling("e").Get(), "ceiling")
+	assert.False(t, m.Ceiling("g").IsPresent(), "no ceiling")
+}
+
+func Test_TreeMap_Range(t *testing.T) {
+	m := NewTree[int, string](compareInts)
+	for i := 0; i < 20; i += 2 {
+		m.Put(i, "")
+	}
+	keys := func(it iterator.Iterator[iterator.MapEntry[int, string]]) []int {
+		out := []int{}
+		for o := it.Next(); o.IsPresent(); o = it.Next() {
+			out = append(out, o.Get().Key)
+		}
+		return out
+	}
+
+	assert.Equal(t, []int{4, 6, 8}, keys(m.Range(3, 10)))
+	assert.Equal(t, []int{4, 6, 8, 10}, keys(m.Range(4, 11)))
+	assert.Empty(t, keys(m.Range(5, 6)))
+	assert.Empty(t, keys(m.Range(30, 40)))
+}
+
+func Test_TreeMap_random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(7))
+	tree := NewTree[int, int](compareInts)
+	model := map[int]int{}
+	for i := 0; i < 3000; i++ {
+		key := rnd.Intn(500)
+		if rnd.Intn(3) == 0 {
+			expected, found := model[key]
+			removed := tree.Remove(key)
+			assert.Equal(t, found, removed.IsPresent())
+			if found {
+				assert.Equal(t, expected, removed.Get())
+			}
+			delete(model, key)
+		} else {
+			tree.Put(key, i)
+			model[key] = i
+		}
+	}
+
+	keys := make([]int, 0, len(model))
+	for key := range model {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	assert.Equal(t, keys, drain(tree.Keys()))
+	assert.Equal(t, len(model), tree.Size())
+	for key, value := range model {
+		assert.Equal(t, value, tree.Get(key).Get())
+	}
+}
+
+func Test_TreeFromIterable(t *testing.T) {
+	entries := []iterator.MapEntry[string, int]{entry("b", 1), entry("a", 2), entry("b", 3)}
+	m := TreeFromIterable[string, int](iterator.ArrayIterable(entries), compareStrings)
+
+	assert.Equal(t, []iterator.MapEntry[string, int]{entry("a", 2), entry("b", 3)}, drain(m.Entries()))
+}
+
+func Test_TreeMap_Entries_Size(t *testing.T) {
+	m := NewTree[string, int](compareStrings)
+	m.Put("x", 1)
+	m.Put("y", 2)
+
+	assert.Equal(t, 2, iterator.Size(m.Entries()).Get(), "entries")
+	assert.Equal(t, 2, iterator.Size(m.Keys()).Get(), "keys")
+	assert.Equal(t, 2, iterator.Size(m.Values()).Get(), "values")
+}
@@ -0,0 +1,195 @@
+package maps
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/internal/linked"
+	"github.com/mikhasd/fluent/iterator"
+)
+
+// MultiMap associates each key with one or more values, iterating over its
+// keys in the order they were first added and over the values of a key in
+// the order they were added.
+//
+// Keys removed while iterating are never returned by the iteration; keys
+// added while iterating may or may not be returned.
+type MultiMap[K comparable, V any] struct {
+	keys *linked.Map[K, []V]
+	size int
+}
+
+// Put adds the value to the values associated with the key.
+func (m *MultiMap[K, V]) Put(key K, value V) {
+	m.PutAll(key, value)
+}
+
+// PutAll adds the values to the values associated with the key.
+func (m *MultiMap[K, V]) PutAll(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+	if node := m.keys.Find(key); node != nil {
+		node.Value = append(node.Value, values...)
+	} else {
+		m.keys.Put(key, append([]V(nil), values...))
+	}
+	m.size += len(values)
+}
+
+// Get returns a copy of the values associated with the key, if any.
+func (m *MultiMap[K, V]) Get(key K) fluent.Option[[]V] {
+	node := m.keys.Find(key)
+	if node == nil {
+		return fluent.Empty[[]V]()
+	}
+	return fluent.Present(append([]V(nil), node.Value...))
+}
+
+// ContainsKey returns true if at least one value is associated with the key.
+func (m *MultiMap[K, V]) ContainsKey(key K) bool {
+	return m.keys.Find(key) != nil
+}
+
+// Count returns the number of values associated with the key.
+func (m *MultiMap[K, V]) Count(key K) int {
+	if node := m.keys.Find(key); node != nil {
+		return len(node.Value)
+	}
+	return 0
+}
+
+// RemoveAll removes the key, returning the values associated with it, if any.
+func (m *MultiMap[K, V]) RemoveAll(key K) fluent.Option[[]V] {
+	removed := m.keys.Remove(key)
+	m.size -= len(removed.OrElse(nil))
+	return removed
+}
+
+// RemoveIf removes the values associated with the key matching the
+// `condition`, returning the number of values removed. The key is removed
+// along with its last value.
+func (m *MultiMap[K, V]) RemoveIf(key K, condition func(V) bool) int {
+	node := m.keys.Find(key)
+	if node == nil {
+		return 0
+	}
+	kept := node.Value[:0]
+	for _, v := range node.Value {
+		if !condition(v) {
+			kept = append(kept, v)
+		}
+	}
+	removed := len(node.Value) - len(kept)
+	var zero V
+	for i := len(kept); i < len(node.Value); i++ {
+		node.Value[i] = zero
+	}
+	node.Value = kept
+	if len(kept) == 0 {
+		m.keys.Remove(key)
+	}
+	m.size -= removed
+	return removed
+}
+
+// Clear removes every key from the multimap.
+func (m *MultiMap[K, V]) Clear() {
+	m.keys.Clear()
+	m.size = 0
+}
+
+// Size returns the total number of values of the multimap.
+func (m *MultiMap[K, V]) Size() int {
+	return m.size
+}
+
+// KeyCount returns the number of distinct keys of the multimap.
+func (m *MultiMap[K, V]) KeyCount() int {
+	return m.keys.Len()
+}
+
+// Empty returns true if the multimap has no values.
+func (m *MultiMap[K, V]) Empty() bool {
+	return m.size == 0
+}
+
+// Keys returns an iterator over the distinct keys of the multimap.
+func (m *MultiMap[K, V]) Keys() iterator.Iterator[K] {
+	return m.keys.Keys()
+}
+
+// Entries returns an iterator over every key and value pair of the multimap.
+func (m *MultiMap[K, V]) Entries() iterator.Iterator[iterator.MapEntry[K, V]] {
+	return &multiMapIterator[K, V]{
+		nodes: m.keys.Nodes(),
+	}
+}
+
+// ForEach calls `fn` with every key and value pair of the multimap.
+func (m *MultiMap[K, V]) ForEach(fn func(K, V)) {
+	m.keys.ForEach(func(key K, values []V) {
+		for _, v := range values {
+			fn(key, v)
+		}
+	})
+}
+
+func (m *MultiMap[K, V]) String() string {
+	var b strings.Builder
+	b.WriteString("MultiMap[")
+	first := true
+	m.keys.ForEach(func(key K, values []V) {
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		fmt.Fprintf(&b, "%+v:%+v", key, values)
+	})
+	b.WriteByte(']')
+	return b.String()
+}
+
+// MultiMap Iterator
+
+// multiMapIterator does not implement iterator.Sized, as values may be added
+// or removed while iterating.
+type multiMapIterator[K comparable, V any] struct {
+	nodes iterator.Iterator[*linked.Node[K, []V]]
+	node  *linked.Node[K, []V]
+	index int
+}
+
+func (it *multiMapIterator[K, V]) Next() fluent.Option[iterator.MapEntry[K, V]] {
+	for it.node == nil || it.index >= len(it.node.Value) {
+		o := it.nodes.Next()
+		if !o.IsPresent() {
+			it.node = nil
+			return fluent.Empty[iterator.MapEntry[K, V]]()
+		}
+		it.node = o.Get()
+		it.index = 0
+	}
+	value := it.node.Value[it.index]
+	it.index++
+	return fluent.Present(entryOf(it.node.Key, value))
+}
+
+// NewMultiMap creates an empty MultiMap.
+func NewMultiMap[K comparable, V any]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{
+		keys: linked.New[K, []V](16),
+	}
+}
+
+// MultiMapFromIterable creates a MultiMap with the entries of the iterable.
+func MultiMapFromIterable[K comparable, V any](iter iterator.Iterable[iterator.MapEntry[K, V]]) *MultiMap[K, V] {
+	m := NewMultiMap[K, V]()
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		e := o.Get()
+		m.Put(e.Key, e.Value)
+	}
+	return m
+}
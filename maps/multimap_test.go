@@ -0,0 +1,84 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MultiMap_Put(t *testing.T) {
+	m := NewMultiMap[string, int]()
+	m.Put("b", 1)
+	m.PutAll("a", 2, 3)
+	m.Put("b", 4)
+	m.PutAll("c")
+
+	assert.Equal(t, 4, m.Size(), "size")
+	assert.Equal(t, 2, m.KeyCount(), "keys")
+	assert.Equal(t, []int{1, 4}, m.Get("b").Get())
+	assert.Equal(t, 2, m.Count("a"))
+	assert.Zero(t, m.Count("c"))
+	assert.False(t, m.Get("c").IsPresent(), "absent")
+	assert.False(t, m.ContainsKey("c"), "no values")
+	assert.Equal(t, "MultiMap[b:[1 4] a:[2 3]]", m.String())
+}
+
+func Test_MultiMap_Get_copy(t *testing.T) {
+	m := NewMultiMap[string, int]()
+	m.PutAll("a", 1, 2)
+	values := m.Get("a").Get()
+	values[0] = 10
+
+	assert.Equal(t, []int{1, 2}, m.Get("a").Get())
+}
+
+func Test_MultiMap_Remove(t *testing.T) {
+	m := NewMultiMap[string, int]()
+	m.PutAll("a", 1, 2, 3, 4)
+	m.PutAll("b", 5)
+
+	assert.Equal(t, 2, m.RemoveIf("a", func(v int) bool { return v%2 == 0 }))
+	assert.Equal(t, []int{1, 3}, m.Get("a").Get())
+	assert.Zero(t, m.RemoveIf("c", func(int) bool { return true }))
+	assert.Equal(t, 1, m.RemoveIf("b", func(int) bool { return true }))
+	assert.False(t, m.ContainsKey("b"), "last value removed")
+
+	assert.Equal(t, []int{1, 3}, m.RemoveAll("a").Get())
+	assert.False(t, m.RemoveAll("a").IsPresent())
+	assert.True(t, m.Empty())
+}
+
+func Test_MultiMap_Entries(t *testing.T) {
+	m := NewMultiMap[string, int]()
+	m.PutAll("b", 1, 2)
+	m.Put("a", 3)
+	it := m.Entries()
+
+	assert.False(t, iterator.Size(it).IsPresent(), "unsized")
+	assert.Equal(t, []iterator.MapEntry[string, int]{entry("b", 1), entry("b", 2), entry("a", 3)}, drain(it))
+	assert.Equal(t, []string{"b", "a"}, drain(m.Keys()))
+
+	m.Clear()
+	assert.Empty(t, drain(m.Entries()))
+}
+
+func Test_MultiMapFromIterable(t *testing.T) {
+	entries := []iterator.MapEntry[string, int]{entry("a", 1), entry("b", 2), entry("a", 3)}
+	m := MultiMapFromIterable[string, int](iterator.ArrayIterable(entries))
+
+	assert.Equal(t, []int{1, 3}, m.Get("a").Get())
+	assert.Equal(t, []int{2}, m.Get("b").Get())
+}
+
+func Test_MultiMap_Entries_removeKey(t *testing.T) {
+	m := NewMultiMap[string, int]()
+	m.Put("a", 1)
+	m.PutAll("b", 2, 3)
+	m.Put("c", 4)
+	it := m.Entries()
+
+	assert.Equal(t, entry("a", 1), it.Next().Get())
+	m.RemoveAll("b")
+	assert.Equal(t, []iterator.MapEntry[string, int]{entry("c", 4)}, drain(it), "removed key skipped")
+}
@@ -0,0 +1,203 @@
+package pq
+
+import (
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+)
+
+// Handle identifies an element of a PriorityQueue, so it can be updated or
+// removed.
+type Handle[T any] struct {
+	value T
+	index int
+	queue *PriorityQueue[T]
+}
+
+// Value returns the element identified by the handle.
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// Queued returns true if the element is still in its queue.
+func (h *Handle[T]) Queued() bool {
+	return h.index >= 0
+}
+
+// PriorityQueue is a queue whose elements are removed in priority order: the
+// element removed first is the lowest according to the `less` function. It is
+// backed by a binary heap, so adding and removing elements take a time
+// proportional to the logarithm of the queue size.
+//
+// A PriorityQueue is not safe for concurrent use.
+type PriorityQueue[T any] struct {
+	heap []*Handle[T]
+	less func(a, b T) bool
+}
+
+// New creates an empty PriorityQueue ordered by the `less` function. To remove
+// the greatest elements first, invert the `less` function.
+func New[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		less: less,
+	}
+}
+
+// FromArray creates a PriorityQueue ordered by the `less` function holding the
+// elements of the array.
+func FromArray[T any](arr []T, less func(a, b T) bool) *PriorityQueue[T] {
+	q := &PriorityQueue[T]{
+		heap: make([]*Handle[T], len(arr)),
+		less: less,
+	}
+	for i, el := range arr {
+		q.heap[i] = &Handle[T]{value: el, index: i, queue: q}
+	}
+	for i := len(arr)/2 - 1; i >= 0; i-- {
+		q.down(i)
+	}
+	return q
+}
+
+// Push adds the element to the queue, returning its handle.
+func (q *PriorityQueue[T]) Push(element T) *Handle[T] {
+	h := &Handle[T]{
+		value: element,
+		index: len(q.heap),
+		queue: q,
+	}
+	q.heap = append(q.heap, h)
+	q.up(h.index)
+	return h
+}
+
+// Pop removes and returns the lowest element of the queue, if any.
+func (q *PriorityQueue[T]) Pop() fluent.Option[T] {
+	if len(q.heap) == 0 {
+		return fluent.Empty[T]()
+	}
+	h := q.heap[0]
+	q.removeAt(0)
+	return fluent.Present(h.value)
+}
+
+// Peek returns the lowest element of the queue, if any, without removing it.
+func (q *PriorityQueue[T]) Peek() fluent.Option[T] {
+	if len(q.heap) == 0 {
+		return fluent.Empty[T]()
+	}
+	return fluent.Present(q.heap[0].value)
+}
+
+// Update replaces the element identified by the handle, moving it to its new
+// position. Update returns false if the element is no longer in the queue.
+func (q *PriorityQueue[T]) Update(h *Handle[T], element T) bool {
+	if !q.owns(h) {
+		return false
+	}
+	h.value = element
+	q.fix(h.index)
+	return true
+}
+
+// Remove removes the element identified by the handle. Remove returns false
+// if the element is no longer in the queue.
+func (q *PriorityQueue[T]) Remove(h *Handle[T]) bool {
+	if !q.owns(h) {
+		return false
+	}
+	q.removeAt(h.index)
+	return true
+}
+
+// Len returns the number of elements of the queue.
+func (q *PriorityQueue[T]) Len() int {
+	return len(q.heap)
+}
+
+// Empty returns true if the queue has no elements.
+func (q *PriorityQueue[T]) Empty() bool {
+	return len(q.heap) == 0
+}
+
+// Drain returns an iterator removing the elements of the queue in priority
+// order as it advances.
+func (q *PriorityQueue[T]) Drain() iterator.Iterator[T] {
+	return &drainIterator[T]{
+		queue: q,
+	}
+}
+
+func (q *PriorityQueue[T]) owns(h *Handle[T]) bool {
+	return h.queue == q && h.index >= 0
+}
+
+func (q *PriorityQueue[T]) removeAt(i int) {
+	h := q.heap[i]
+	last := len(q.heap) - 1
+	if i != last {
+		q.swap(i, last)
+	}
+	q.heap[last] = nil
+	q.heap = q.heap[:last]
+	if i != last {
+		q.fix(i)
+	}
+	h.index = -1
+}
+
+func (q *PriorityQueue[T]) fix(i int) {
+	if !q.down(i) {
+		q.up(i)
+	}
+}
+
+func (q *PriorityQueue[T]) swap(i, j int) {
+	q.heap[i], q.heap[j] = q.heap[j], q.heap[i]
+	q.heap[i].index = i
+	q.heap[j].index = j
+}
+
+func (q *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(q.heap[i].value, q.heap[parent].value) {
+			break
+		}
+		q.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the element at `i` towards the leaves, returning true if it
+// moved.
+func (q *PriorityQueue[T]) down(i int) bool {
+	start := i
+	for {
+		lowest := i
+		for _, child := range [2]int{2*i + 1, 2*i + 2} {
+			if child < len(q.heap) && q.less(q.heap[child].value, q.heap[lowest].value) {
+				lowest = child
+			}
+		}
+		if lowest == i {
+			return i > start
+		}
+		q.swap(i, lowest)
+		i = lowest
+	}
+}
+
+// Drain Iterator
+
+type drainIterator[T any] struct {
+	queue *PriorityQueue[T]
+}
+
+func (it *drainIterator[T]) Next() fluent.Option[T] {
+	return it.queue.Pop()
+}
+
+// Implements iterator.Sized interface
+func (it *drainIterator[T]) Size() fluent.Option[int] {
+	return fluent.Present(it.queue.Len())
+}
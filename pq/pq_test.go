@@ -0,0 +1,143 @@
+package pq
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+func lessInts(a, b int) bool {
+	return a < b
+}
+
+func drain[T any](q *PriorityQueue[T]) []T {
+	out := []T{}
+	it := q.Drain()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		out = append(out, o.Get())
+	}
+	return out
+}
+
+func Test_PriorityQueue_Push(t *testing.T) {
+	q := New(lessInts)
+	for _, el := range []int{5, 3, 8, 1, 9, 2} {
+		q.Push(el)
+	}
+
+	assert.Equal(t, 6, q.Len())
+	assert.Equal(t, 1, q.Peek().Get(), "peek")
+	assert.Equal(t, []int{1, 2, 3, 5, 8, 9}, drain(q))
+	assert.True(t, q.Empty(), "drained")
+}
+
+func Test_PriorityQueue_Pop(t *testing.T) {
+	q := New(func(a, b string) bool { return a > b })
+	q.Push("a")
+	q.Push("c")
+	q.Push("b")
+
+	assert.Equal(t, "c", q.Pop().Get())
+	assert.Equal(t, "b", q.Pop().Get())
+	assert.Equal(t, "a", q.Pop().Get())
+	assert.False(t, q.Pop().IsPresent(), "pop empty")
+	assert.False(t, q.Peek().IsPresent(), "peek empty")
+}
+
+func Test_PriorityQueue_FromArray(t *testing.T) {
+	arr := []int{7, 2, 9, 4, 4, 0, 6}
+	q := FromArray(arr, lessInts)
+
+	assert.Equal(t, []int{0, 2, 4, 4, 6, 7, 9}, drain(q))
+	assert.Equal(t, []int{7, 2, 9, 4, 4, 0, 6}, arr, "source untouched")
+}
+
+func Test_PriorityQueue_Update(t *testing.T) {
+	q := New(lessInts)
+	q.Push(10)
+	h := q.Push(20)
+	q.Push(30)
+
+	assert.True(t, q.Update(h, 5), "decrease")
+	assert.Equal(t, 5, q.Peek().Get())
+	assert.Equal(t, 5, h.Value())
+
+	assert.True(t, q.Update(h, 40), "increase")
+	assert.Equal(t, []int{10, 30, 40}, drain(q))
+	assert.False(t, h.Queued(), "drained handle")
+	assert.False(t, q.Update(h, 1), "update drained")
+	assert.True(t, q.Empty())
+}
+
+func Test_PriorityQueue_Remove(t *testing.T) {
+	q := New(lessInts)
+	handles := make([]*Handle[int], 0)
+	for i := 0; i < 10; i++ {
+		handles = append(handles, q.Push(i))
+	}
+
+	assert.True(t, q.Remove(handles[0]), "root")
+	assert.True(t, q.Remove(handles[5]), "inner")
+	assert.True(t, q.Remove(handles[9]), "last")
+	assert.False(t, q.Remove(handles[5]), "removed twice")
+	assert.False(t, handles[5].Queued())
+	assert.Equal(t, []int{1, 2, 3, 4, 6, 7, 8}, drain(q))
+}
+
+func Test_PriorityQueue_foreignHandle(t *testing.T) {
+	q := New(lessInts)
+	other := New(lessInts)
+	h := other.Push(1)
+
+	assert.False(t, q.Update(h, 2), "update")
+	assert.False(t, q.Remove(h), "remove")
+	assert.Equal(t, 1, other.Len())
+}
+
+func Test_PriorityQueue_Drain(t *testing.T) {
+	q := New(lessInts)
+	q.Push(2)
+	q.Push(1)
+	it := q.Drain()
+
+	assert.Equal(t, 2, iterator.Size(it).Get(), "size")
+	assert.Equal(t, 1, it.Next().Get())
+	assert.Equal(t, 1, q.Len(), "removed while draining")
+	assert.Equal(t, 1, iterator.Size(it).Get(), "remaining")
+}
+
+func Test_PriorityQueue_random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	q := New(lessInts)
+	model := map[*Handle[int]]int{}
+	for i := 0; i < 2000; i++ {
+		switch op := rnd.Intn(4); {
+		case op < 2 || len(model) == 0:
+			v := rnd.Intn(1000)
+			model[q.Push(v)] = v
+		case op == 2:
+			for h := range model {
+				v := rnd.Intn(1000)
+				assert.True(t, q.Update(h, v))
+				model[h] = v
+				break
+			}
+		default:
+			for h := range model {
+				assert.True(t, q.Remove(h))
+				delete(model, h)
+				break
+			}
+		}
+	}
+
+	expected := make([]int, 0, len(model))
+	for _, v := range model {
+		expected = append(expected, v)
+	}
+	sort.Ints(expected)
+	assert.Equal(t, expected, drain(q))
+}
@@ -0,0 +1,186 @@
+package set
+
+import (
+	"sort"
+
+	"github.com/mikhasd/fluent/iterator"
+)
+
+// Multiset is a collection of elements which, unlike a Set, keeps track of
+// how many times each element was added.
+type Multiset[T comparable] struct {
+	counts map[T]int
+	size   int
+}
+
+// Add adds `n` occurrences of the element. Add panics if `n` is negative.
+func (m *Multiset[T]) Add(element T, n int) {
+	if n < 0 {
+		panic("negative occurrence count")
+	}
+	if n == 0 {
+		return
+	}
+	m.counts[element] += n
+	m.size += n
+}
+
+// AddAll adds one occurrence of each element of the iterable.
+func (m *Multiset[T]) AddAll(iter iterator.Iterable[T]) {
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		m.Add(o.Get(), 1)
+	}
+}
+
+// Remove removes up to `n` occurrences of the element, returning the number of
+// occurrences removed. Remove panics if `n` is negative.
+func (m *Multiset[T]) Remove(element T, n int) int {
+	if n < 0 {
+		panic("negative occurrence count")
+	}
+	count := m.counts[element]
+	if n >= count {
+		delete(m.counts, element)
+		m.size -= count
+		return count
+	}
+	m.counts[element] = count - n
+	m.size -= n
+	return n
+}
+
+// Count returns the number of occurrences of the element.
+func (m *Multiset[T]) Count(element T) int {
+	return m.counts[element]
+}
+
+// Contains returns true if there is at least one occurrence of the element.
+func (m *Multiset[T]) Contains(element T) bool {
+	return m.counts[element] > 0
+}
+
+// Size returns the total number of occurrences of all elements.
+func (m *Multiset[T]) Size() int {
+	return m.size
+}
+
+// Empty returns true if the multiset has no elements.
+func (m *Multiset[T]) Empty() bool {
+	return m.size == 0
+}
+
+// Distinct returns a Set with the distinct elements of the multiset.
+func (m *Multiset[T]) Distinct() Set[T] {
+	s := WithSize[T](len(m.counts))
+	for el := range m.counts {
+		s.Add(el)
+	}
+	return s
+}
+
+// ForEach calls `fn` with each distinct element and its number of
+// occurrences.
+func (m *Multiset[T]) ForEach(fn func(T, int)) {
+	for el, count := range m.counts {
+		fn(el, count)
+	}
+}
+
+// Iterator returns an iterator over the distinct elements and their number of
+// occurrences.
+func (m *Multiset[T]) Iterator() iterator.Iterator[iterator.MapEntry[T, int]] {
+	return iterator.FromMap(m.counts)
+}
+
+// MostCommon returns the `k` elements with the most occurrences, along with
+// their number of occurrences, from the most to the least common. The order
+// of elements with the same number of occurrences is unspecified.
+//
+// If `k` is negative or greater than the number of distinct elements, every
+// element is returned.
+func (m *Multiset[T]) MostCommon(k int) []iterator.MapEntry[T, int] {
+	entries := make([]iterator.MapEntry[T, int], 0, len(m.counts))
+	for el, count := range m.counts {
+		entries = append(entries, iterator.MapEntry[T, int]{Key: el, Value: count})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Value > entries[j].Value
+	})
+	if k >= 0 && k < len(entries) {
+		entries = entries[:k]
+	}
+	return entries
+}
+
+// Union returns a new multiset where each element occurs the maximum number
+// of times it occurs in either multiset.
+func (m *Multiset[T]) Union(other *Multiset[T]) *Multiset[T] {
+	result := m.Clone()
+	for el, count := range other.counts {
+		if extra := count - result.counts[el]; extra > 0 {
+			result.Add(el, extra)
+		}
+	}
+	return result
+}
+
+// Intersection returns a new multiset where each element occurs the minimum
+// number of times it occurs in both multisets.
+func (m *Multiset[T]) Intersection(other *Multiset[T]) *Multiset[T] {
+	small, large := m, other
+	if len(large.counts) < len(small.counts) {
+		small, large = large, small
+	}
+	result := NewMultiset[T]()
+	for el, count := range small.counts {
+		if c := large.counts[el]; c < count {
+			count = c
+		}
+		result.Add(el, count)
+	}
+	return result
+}
+
+// Sum returns a new multiset where each element occurs the number of times it
+// occurs in both multisets combined.
+func (m *Multiset[T]) Sum(other *Multiset[T]) *Multiset[T] {
+	result := m.Clone()
+	for el, count := range other.counts {
+		result.Add(el, count)
+	}
+	return result
+}
+
+// Clone returns a copy of the multiset.
+func (m *Multiset[T]) Clone() *Multiset[T] {
+	counts := make(map[T]int, len(m.counts))
+	for el, count := range m.counts {
+		counts[el] = count
+	}
+	return &Multiset[T]{
+		counts: counts,
+		size:   m.size,
+	}
+}
+
+// NewMultiset creates an empty Multiset.
+func NewMultiset[T comparable]() *Multiset[T] {
+	return &Multiset[T]{
+		counts: make(map[T]int),
+	}
+}
+
+// MultisetFromIterable creates a Multiset counting the elements of the
+// iterable. Streams are iterables, so a Multiset can be built from a stream
+// pipeline.
+func MultisetFromIterable[T comparable](iter iterator.Iterable[T]) *Multiset[T] {
+	m := NewMultiset[T]()
+	m.AddAll(iter)
+	return m
+}
+
+// MultisetFromArray creates a Multiset counting the elements of the array.
+func MultisetFromArray[T comparable](arr []T) *Multiset[T] {
+	return MultisetFromIterable(iterator.ArrayIterable(arr))
+}
@@ -0,0 +1,112 @@
+package set
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Multiset_Add(t *testing.T) {
+	m := NewMultiset[string]()
+	m.Add("a", 2)
+	m.Add("b", 1)
+	m.Add("a", 3)
+	m.Add("c", 0)
+
+	assert.Equal(t, 5, m.Count("a"), "a")
+	assert.Equal(t, 1, m.Count("b"), "b")
+	assert.Equal(t, 0, m.Count("c"), "c")
+	assert.False(t, m.Contains("c"), "contains")
+	assert.Equal(t, 6, m.Size(), "size")
+	assert.Panics(t, func() { m.Add("a", -1) }, "negative")
+}
+
+func Test_Multiset_Remove(t *testing.T) {
+	m := MultisetFromArray([]string{"a", "a", "a", "b"})
+
+	assert.Equal(t, 2, m.Remove("a", 2), "partial")
+	assert.Equal(t, 1, m.Count("a"), "partial")
+	assert.Equal(t, 1, m.Remove("a", 5), "all")
+	assert.False(t, m.Contains("a"), "all")
+	assert.Equal(t, 0, m.Remove("z", 1), "absent")
+	assert.Equal(t, 1, m.Size(), "size")
+	assert.Panics(t, func() { m.Remove("b", -1) }, "negative")
+
+	m.Remove("b", 1)
+	assert.True(t, m.Empty(), "empty")
+}
+
+func Test_Multiset_Distinct(t *testing.T) {
+	m := MultisetFromArray([]int{1, 1, 2, 3, 3, 3})
+	d := m.Distinct()
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, elements(d))
+}
+
+func Test_Multiset_MostCommon(t *testing.T) {
+	m := MultisetFromArray([]string{"a", "b", "b", "c", "c", "c", "d", "d", "d", "d"})
+
+	expected := []iterator.MapEntry[string, int]{{Key: "d", Value: 4}, {Key: "c", Value: 3}}
+	assert.Equal(t, expected, m.MostCommon(2))
+	assert.Len(t, m.MostCommon(-1), 4, "all")
+	assert.Len(t, m.MostCommon(10), 4, "all")
+	assert.Empty(t, m.MostCommon(0), "none")
+}
+
+func Test_Multiset_Union(t *testing.T) {
+	a := MultisetFromArray([]int{1, 1, 2})
+	b := MultisetFromArray([]int{1, 2, 2, 3})
+	u := a.Union(b)
+
+	assert.Equal(t, 2, u.Count(1))
+	assert.Equal(t, 2, u.Count(2))
+	assert.Equal(t, 1, u.Count(3))
+	assert.Equal(t, 5, u.Size())
+	assert.Equal(t, 3, a.Size(), "unchanged")
+}
+
+func Test_Multiset_Intersection(t *testing.T) {
+	a := MultisetFromArray([]int{1, 1, 2, 4})
+	b := MultisetFromArray([]int{1, 2, 2, 3})
+	i := a.Intersection(b)
+
+	assert.Equal(t, 1, i.Count(1))
+	assert.Equal(t, 1, i.Count(2))
+	assert.False(t, i.Contains(3))
+	assert.False(t, i.Contains(4))
+	assert.Equal(t, 2, i.Size())
+	assert.Equal(t, 2, b.Intersection(a).Size(), "commutative")
+}
+
+func Test_Multiset_Sum(t *testing.T) {
+	a := MultisetFromArray([]int{1, 1, 2})
+	b := MultisetFromArray([]int{1, 3})
+	s := a.Sum(b)
+
+	assert.Equal(t, 3, s.Count(1))
+	assert.Equal(t, 5, s.Size())
+}
+
+func Test_Multiset_Iterator(t *testing.T) {
+	m := MultisetFromArray([]string{"x", "y", "x"})
+
+	expected := []iterator.MapEntry[string, int]{{Key: "x", Value: 2}, {Key: "y", Value: 1}}
+	assert.ElementsMatch(t, expected, drain(m.Iterator()))
+
+	counted := map[string]int{}
+	m.ForEach(func(el string, count int) {
+		counted[el] = count
+	})
+	assert.Equal(t, map[string]int{"x": 2, "y": 1}, counted)
+}
+
+func Test_MultisetFromIterable_stream(t *testing.T) {
+	words := stream.Map(stream.Of("Go", "go", "GO", "fluent"), strings.ToLower)
+	m := MultisetFromIterable[string](words)
+
+	assert.Equal(t, 3, m.Count("go"))
+	assert.Equal(t, 1, m.Count("fluent"))
+}
@@ -0,0 +1,191 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/internal/hash"
+)
+
+// bloomVersion identifies the binary encoding of a Bloom filter.
+const bloomVersion = 1
+
+// bloomHeader is the size of the binary encoding before the filter words:
+// version, number of hash functions and number of bits.
+const bloomHeader = 1 + 4 + 8
+
+// Bloom is a space efficient probabilistic set. Contains never reports false
+// negatives, but may report an element which was never added as present.
+//
+// A Bloom filter is not safe for concurrent use.
+type Bloom[T any] struct {
+	words  []uint64
+	m      uint64
+	k      uint32
+	hasher func(T) uint64
+}
+
+// Add adds the element to the filter. It returns true if the element was
+// certainly not present before, or false if it may have been.
+func (b *Bloom[T]) Add(element T) bool {
+	h1, h2 := b.hashes(element)
+	added := false
+	for i := uint32(0); i < b.k; i++ {
+		bit := (h1 + uint64(i)*h2) % b.m
+		word, mask := bit/64, uint64(1)<<(bit%64)
+		if b.words[word]&mask == 0 {
+			b.words[word] |= mask
+			added = true
+		}
+	}
+	return added
+}
+
+// Contains returns false if the element was certainly never added to the
+// filter, or true if it probably was.
+func (b *Bloom[T]) Contains(element T) bool {
+	h1, h2 := b.hashes(element)
+	for i := uint32(0); i < b.k; i++ {
+		bit := (h1 + uint64(i)*h2) % b.m
+		if b.words[bit/64]&(uint64(1)<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// hashes derives the two hashes combined into the `k` bit positions of the
+// element, following Kirsch and Mitzenmacher.
+func (b *Bloom[T]) hashes(element T) (uint64, uint64) {
+	h := b.hasher(element)
+	return h, hash.Mix(h) | 1
+}
+
+// Bits returns the number of bits of the filter.
+func (b *Bloom[T]) Bits() int {
+	return int(b.m)
+}
+
+// Hashes returns the number of bits set for each element.
+func (b *Bloom[T]) Hashes() int {
+	return int(b.k)
+}
+
+// FalsePositiveRate estimates the current probability of Contains reporting
+// an element which was never added, based on the proportion of bits set.
+func (b *Bloom[T]) FalsePositiveRate() float64 {
+	set := 0
+	for _, w := range b.words {
+		set += bits.OnesCount64(w)
+	}
+	return math.Pow(float64(set)/float64(b.m), float64(b.k))
+}
+
+// Clear removes every element from the filter.
+func (b *Bloom[T]) Clear() {
+	for i := range b.words {
+		b.words[i] = 0
+	}
+}
+
+// Merge adds the elements of the other filter to this filter. Both filters
+// must have the same number of bits and hash functions.
+func (b *Bloom[T]) Merge(other *Bloom[T]) {
+	if b.m != other.m || b.k != other.k {
+		panic("bloom filters have different parameters")
+	}
+	for i, w := range other.words {
+		b.words[i] |= w
+	}
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (b *Bloom[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, bloomHeader+8*len(b.words))
+	data[0] = bloomVersion
+	binary.BigEndian.PutUint32(data[1:], b.k)
+	binary.BigEndian.PutUint64(data[5:], b.m)
+	for i, w := range b.words {
+		binary.BigEndian.PutUint64(data[bloomHeader+8*i:], w)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface,
+// replacing the contents and parameters of the filter. The filter keeps its
+// hasher, which must be the one used by the marshaled filter.
+func (b *Bloom[T]) UnmarshalBinary(data []byte) error {
+	if b.hasher == nil {
+		return errors.New("bloom: filter has no hasher")
+	}
+	if len(data) < bloomHeader {
+		return errors.New("bloom: data too short")
+	}
+	if data[0] != bloomVersion {
+		return errors.New("bloom: unsupported version")
+	}
+	k := binary.BigEndian.Uint32(data[1:])
+	m := binary.BigEndian.Uint64(data[5:])
+	if k == 0 || m == 0 || m > uint64(len(data)-bloomHeader)*8 {
+		return errors.New("bloom: invalid parameters")
+	}
+	words := (m + 63) / 64
+	if uint64(len(data)-bloomHeader) != 8*words {
+		return errors.New("bloom: invalid data length")
+	}
+	b.k, b.m = k, m
+	b.words = make([]uint64, words)
+	for i := range b.words {
+		b.words[i] = binary.BigEndian.Uint64(data[bloomHeader+8*i:])
+	}
+	return nil
+}
+
+// NewBloom creates a Bloom filter sized to hold `expected` elements with a
+// false positive rate close to `rate`, hashing the elements with Hash.
+//
+// NewBloom panics if `expected` is not positive or `rate` is not between 0
+// and 1.
+func NewBloom[T comparable](expected int, rate float64) *Bloom[T] {
+	return BloomWithHasher(expected, rate, Hash[T])
+}
+
+// BloomWithHasher creates a Bloom filter sized to hold `expected` elements
+// with a false positive rate close to `rate`, hashing the elements with the
+// given `hasher`.
+//
+// BloomWithHasher panics if `expected` is not positive or `rate` is not
+// between 0 and 1.
+func BloomWithHasher[T any](expected int, rate float64, hasher func(T) uint64) *Bloom[T] {
+	if expected <= 0 {
+		panic("expected number of elements must be positive")
+	}
+	if !(rate > 0 && rate < 1) {
+		panic("false positive rate must be between 0 and 1")
+	}
+	n := float64(expected)
+	m := uint64(math.Ceil(-n * math.Log(rate) / (math.Ln2 * math.Ln2)))
+	k := uint32(math.Round(float64(m) / n * math.Ln2))
+	if k == 0 {
+		k = 1
+	}
+	return &Bloom[T]{
+		words:  make([]uint64, (m+63)/64),
+		m:      m,
+		k:      k,
+		hasher: hasher,
+	}
+}
+
+// BloomFromBytes restores a Bloom filter marshaled with MarshalBinary, hashing
+// the elements with Hash.
+func BloomFromBytes[T comparable](data []byte) fluent.Result[*Bloom[T]] {
+	b := &Bloom[T]{hasher: Hash[T]}
+	if err := b.UnmarshalBinary(data); err != nil {
+		return fluent.Err[*Bloom[T]](err)
+	}
+	return fluent.Ok(b)
+}
@@ -0,0 +1,153 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewBloom(t *testing.T) {
+	b := NewBloom[int](1000, 0.01)
+
+	// m = -n ln(p) / ln(2)^2, k = m/n ln(2)
+	assert.Equal(t, 9586, b.Bits(), "bits")
+	assert.Equal(t, 7, b.Hashes(), "hashes")
+}
+
+func Test_NewBloom_invalid(t *testing.T) {
+	assert.Panics(t, func() { NewBloom[int](0, 0.01) }, "expected")
+	assert.Panics(t, func() { NewBloom[int](10, 0) }, "zero rate")
+	assert.Panics(t, func() { NewBloom[int](10, 1) }, "one rate")
+}
+
+func Test_Bloom_Add(t *testing.T) {
+	b := NewBloom[string](100, 0.01)
+
+	assert.True(t, b.Add("a"), "new")
+	assert.False(t, b.Add("a"), "duplicate")
+	assert.True(t, b.Contains("a"), "contains")
+	assert.False(t, b.Contains("b"), "absent")
+}
+
+func Test_Bloom_no_false_negatives(t *testing.T) {
+	b := NewBloom[int](10000, 0.01)
+	for i := 0; i < 10000; i++ {
+		b.Add(i)
+	}
+	for i := 0; i < 10000; i++ {
+		if !b.Contains(i) {
+			t.Fatalf("false negative for %d", i)
+		}
+	}
+}
+
+func Test_Bloom_false_positive_rate(t *testing.T) {
+	b := NewBloom[int](10000, 0.01)
+	for i := 0; i < 10000; i++ {
+		b.Add(i)
+	}
+	falsePositives := 0
+	for i := 10000; i < 110000; i++ {
+		if b.Contains(i) {
+			falsePositives++
+		}
+	}
+	rate := float64(falsePositives) / 100000
+
+	assert.InDelta(t, 0.01, rate, 0.005, "observed")
+	assert.InDelta(t, 0.01, b.FalsePositiveRate(), 0.005, "estimated")
+}
+
+func Test_Bloom_Clear(t *testing.T) {
+	b := NewBloom[int](10, 0.01)
+	b.Add(1)
+	b.Clear()
+
+	assert.False(t, b.Contains(1))
+	assert.Zero(t, b.FalsePositiveRate())
+}
+
+func Test_Bloom_Merge(t *testing.T) {
+	a := NewBloom[int](100, 0.01)
+	b := NewBloom[int](100, 0.01)
+	a.Add(1)
+	b.Add(2)
+	a.Merge(b)
+
+	assert.True(t, a.Contains(1))
+	assert.True(t, a.Contains(2))
+	assert.Panics(t, func() { a.Merge(NewBloom[int](200, 0.01)) })
+}
+
+func Test_Bloom_MarshalBinary(t *testing.T) {
+	b := NewBloom[string](100, 0.01)
+	b.Add("a")
+	b.Add("b")
+	data, err := b.MarshalBinary()
+	assert.NoError(t, err)
+
+	r := BloomFromBytes[string](data)
+	assert.True(t, r.IsOk(), "ok")
+	restored := r.Get()
+	assert.Equal(t, b.Bits(), restored.Bits(), "bits")
+	assert.Equal(t, b.Hashes(), restored.Hashes(), "hashes")
+	assert.True(t, restored.Contains("a"), "a")
+	assert.True(t, restored.Contains("b"), "b")
+	assert.False(t, restored.Contains("c"), "c")
+}
+
+func Test_Bloom_UnmarshalBinary(t *testing.T) {
+	b := NewBloom[int](100, 0.01)
+	b.Add(7)
+	data, _ := b.MarshalBinary()
+
+	other := NewBloom[int](10, 0.1)
+	assert.NoError(t, other.UnmarshalBinary(data))
+	assert.Equal(t, b.Bits(), other.Bits())
+	assert.True(t, other.Contains(7))
+}
+
+func Test_BloomFromBytes_invalid(t *testing.T) {
+	data, _ := NewBloom[int](100, 0.01).MarshalBinary()
+
+	assert.True(t, BloomFromBytes[int](data[:5]).IsErr(), "short")
+	assert.True(t, BloomFromBytes[int](data[:len(data)-1]).IsErr(), "truncated")
+	data[0] = 99
+	assert.True(t, BloomFromBytes[int](data).IsErr(), "version")
+	assert.Error(t, (&Bloom[int]{}).UnmarshalBinary(data), "hasher")
+}
+
+func Test_BloomFromBytes_overflow(t *testing.T) {
+	data := make([]byte, bloomHeader)
+	data[0] = bloomVersion
+	binary.BigEndian.PutUint32(data[1:], 1)
+	binary.BigEndian.PutUint64(data[5:], math.MaxUint64)
+
+	assert.True(t, BloomFromBytes[int](data).IsErr(), "no words")
+	data = append(data, make([]byte, 8)...)
+	assert.True(t, BloomFromBytes[int](data).IsErr(), "one word")
+}
+
+func Test_BloomWithHasher(t *testing.T) {
+	b := BloomWithHasher(100, 0.01, func(s []byte) uint64 {
+		return Hash(string(s))
+	})
+	b.Add([]byte("a"))
+
+	assert.True(t, b.Contains([]byte("a")))
+}
+
+type hashTestPoint struct {
+	X, Y float64
+}
+
+func Test_Bloom_pointer(t *testing.T) {
+	b := NewBloom[*hashTestPoint](100, 0.01)
+	p := &hashTestPoint{1, 2}
+	b.Add(p)
+	p.X = 3
+
+	assert.True(t, b.Contains(p), "no false negative after the pointee changed")
+}
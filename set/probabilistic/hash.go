@@ -0,0 +1,9 @@
+package probabilistic
+
+import "github.com/mikhasd/fluent/internal/hash"
+
+// Hash is the default hasher of the filters and counters of this package. It
+// is exported to be reused or wrapped by custom hashers.
+func Hash[T comparable](value T) uint64 {
+	return hash.Of(value)
+}
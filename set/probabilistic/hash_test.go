@@ -0,0 +1,52 @@
+package probabilistic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Hash_registers(t *testing.T) {
+	// HyperLogLog picks the register from the top bits of the hash.
+	const precision = 10
+	registers := make(map[uint64]bool)
+	for i := 0; i < 16<<precision; i++ {
+		registers[Hash(i)>>(64-precision)] = true
+	}
+
+	assert.Equal(t, 1<<precision, len(registers), "sequential keys reach every register")
+}
+
+func Test_Bloom_hashes_spread(t *testing.T) {
+	b := NewBloom[int](1000, 0.01)
+	hits := make([]int, 4)
+	for i := 0; i < 1000; i++ {
+		h1, h2 := b.hashes(i)
+		for j := uint64(0); j < uint64(b.k); j++ {
+			hits[(h1+j*h2)%b.m*4/b.m]++
+		}
+	}
+
+	expected := 1000 * int(b.k) / 4
+	for quarter, n := range hits {
+		assert.InDelta(t, expected, n, float64(expected)/10, "quarter %d", quarter)
+	}
+}
+
+func Test_Bloom_hashes_distinct(t *testing.T) {
+	b := NewBloom[int](1000, 0.01)
+	collisions := 0
+	for i := 0; i < 1000; i++ {
+		h1, h2 := b.hashes(i)
+		seen := make(map[uint64]bool)
+		for j := uint64(0); j < uint64(b.k); j++ {
+			bit := (h1 + j*h2) % b.m
+			if seen[bit] {
+				collisions++
+			}
+			seen[bit] = true
+		}
+	}
+
+	assert.Less(t, collisions, 10, "the k positions of an element rarely collide")
+}
@@ -0,0 +1,105 @@
+package probabilistic
+
+import (
+	"math"
+	"math/bits"
+)
+
+// HyperLogLog estimates the number of distinct elements added to it using a
+// fixed amount of memory. The standard error of the estimate is about
+// 1.04/sqrt(2^precision).
+//
+// A HyperLogLog is not safe for concurrent use.
+type HyperLogLog[T any] struct {
+	registers []uint8
+	precision uint8
+	hasher    func(T) uint64
+}
+
+// Add adds the element to the counter.
+func (h *HyperLogLog[T]) Add(element T) {
+	hash := h.hasher(element)
+	index := hash >> (64 - h.precision)
+	// The guard bit bounds the rank when the remaining bits are all zeros.
+	rest := hash<<h.precision | 1<<(h.precision-1)
+	rank := uint8(bits.LeadingZeros64(rest) + 1)
+	if rank > h.registers[index] {
+		h.registers[index] = rank
+	}
+}
+
+// Count returns the estimated number of distinct elements added to the
+// counter.
+func (h *HyperLogLog[T]) Count() int {
+	m := float64(len(h.registers))
+	sum := 0.0
+	zeros := 0
+	for _, r := range h.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+	estimate := alpha(len(h.registers)) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// Linear counting is more accurate for small cardinalities.
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return int(math.Round(estimate))
+}
+
+// Merge adds the elements counted by the other counter to this counter. Both
+// counters must have the same precision.
+func (h *HyperLogLog[T]) Merge(other *HyperLogLog[T]) {
+	if h.precision != other.precision {
+		panic("hyperloglog counters have different precisions")
+	}
+	for i, r := range other.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+}
+
+// Clear resets the counter.
+func (h *HyperLogLog[T]) Clear() {
+	for i := range h.registers {
+		h.registers[i] = 0
+	}
+}
+
+func alpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	default:
+		return 0.7213 / (1 + 1.079/float64(m))
+	}
+}
+
+// NewHyperLogLog creates a HyperLogLog counter using 2^precision registers,
+// hashing the elements with Hash.
+//
+// NewHyperLogLog panics if `precision` is not between 4 and 18.
+func NewHyperLogLog[T comparable](precision int) *HyperLogLog[T] {
+	return HyperLogLogWithHasher(precision, Hash[T])
+}
+
+// HyperLogLogWithHasher creates a HyperLogLog counter using 2^precision
+// registers, hashing the elements with the given `hasher`.
+//
+// HyperLogLogWithHasher panics if `precision` is not between 4 and 18.
+func HyperLogLogWithHasher[T any](precision int, hasher func(T) uint64) *HyperLogLog[T] {
+	if precision < 4 || precision > 18 {
+		panic("precision must be between 4 and 18")
+	}
+	return &HyperLogLog[T]{
+		registers: make([]uint8, 1<<precision),
+		precision: uint8(precision),
+		hasher:    hasher,
+	}
+}
@@ -0,0 +1,60 @@
+package probabilistic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_HyperLogLog_Count(t *testing.T) {
+	for _, n := range []int{10, 1000, 100000} {
+		h := NewHyperLogLog[int](14)
+		for i := 0; i < n; i++ {
+			h.Add(i)
+			h.Add(i)
+		}
+		assert.InEpsilon(t, n, h.Count(), 0.03, "n=%d", n)
+	}
+}
+
+func Test_HyperLogLog_Count_empty(t *testing.T) {
+	h := NewHyperLogLog[string](10)
+
+	assert.Equal(t, 0, h.Count())
+}
+
+func Test_HyperLogLog_strings(t *testing.T) {
+	h := NewHyperLogLog[string](12)
+	for i := 0; i < 50000; i++ {
+		h.Add(fmt.Sprintf("user-%d", i%20000))
+	}
+
+	assert.InEpsilon(t, 20000, h.Count(), 0.05)
+}
+
+func Test_HyperLogLog_Merge(t *testing.T) {
+	a := NewHyperLogLog[int](12)
+	b := NewHyperLogLog[int](12)
+	for i := 0; i < 10000; i++ {
+		a.Add(i)
+		b.Add(i + 5000)
+	}
+	a.Merge(b)
+
+	assert.InEpsilon(t, 15000, a.Count(), 0.05)
+	assert.Panics(t, func() { a.Merge(NewHyperLogLog[int](10)) })
+}
+
+func Test_HyperLogLog_Clear(t *testing.T) {
+	h := NewHyperLogLog[int](8)
+	h.Add(1)
+	h.Clear()
+
+	assert.Equal(t, 0, h.Count())
+}
+
+func Test_NewHyperLogLog_invalid(t *testing.T) {
+	assert.Panics(t, func() { NewHyperLogLog[int](3) })
+	assert.Panics(t, func() { NewHyperLogLog[int](19) })
+}
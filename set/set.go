@@ -1,15 +1,70 @@
 package set
 
-import "github.com/mikhasd/fluent/iterator"
+import (
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/stream"
+)
 
-type Set[T any] interface {
+// ReadOnly is the subset of the Set operations which do not modify the set.
+type ReadOnly[T any] interface {
 	Contains(T) bool
 	ContainsAll(iterator.Iterable[T]) bool
-	Add(T)
-	AddAll(iterator.Iterable[T])
 	Iterator() iterator.Iterator[T]
-	Remove(T)
 	Empty() bool
 	Size() int
 	ForEach(fn func(T))
+	// ToSlice returns a new array with the elements of the set.
+	ToSlice() []T
+	// Stream returns a stream with the elements of the set as data source.
+	Stream() stream.Stream[T]
+	// String returns a representation of the set listing its elements.
+	String() string
+}
+
+type Set[T any] interface {
+	ReadOnly[T]
+	// Add adds the element to the set, returning true if it was not present.
+	Add(T) bool
+	AddAll(iterator.Iterable[T])
+	// Remove removes the element from the set, returning true if it was
+	// present.
+	Remove(T) bool
+	// Clear removes every element from the set.
+	Clear()
+	// Clone returns a new set of the same kind and configuration with the
+	// elements of this set.
+	Clone() Set[T]
+	// Filter returns a new set of the same kind and configuration with the
+	// elements of this set matching the `condition`.
+	Filter(condition func(T) bool) Set[T]
+
+	// Union returns a new set with the elements present in either this set or
+	// the `other` set.
+	Union(other Set[T]) Set[T]
+	// Intersection returns a new set with the elements present in both this
+	// set and the `other` set.
+	Intersection(other Set[T]) Set[T]
+	// Difference returns a new set with the elements of this set which are not
+	// present in the `other` set.
+	Difference(other Set[T]) Set[T]
+	// SymmetricDifference returns a new set with the elements present in only
+	// one of this set and the `other` set.
+	SymmetricDifference(other Set[T]) Set[T]
+	// IsSubsetOf returns true if every element of this set is present in the
+	// `other` set.
+	IsSubsetOf(other Set[T]) bool
+	// IsSupersetOf returns true if every element of the `other` set is present
+	// in this set.
+	IsSupersetOf(other Set[T]) bool
+	// IsDisjoint returns true if this set and the `other` set have no elements
+	// in common.
+	IsDisjoint(other Set[T]) bool
+	// Equal returns true if this set and the `other` set have the same
+	// elements.
+	Equal(other Set[T]) bool
+	// RetainAll removes from this set the elements which are not present in
+	// the `other` set.
+	RetainAll(other Set[T])
+	// RemoveAll removes from this set every element of the provided iterable.
+	RemoveAll(iterator.Iterable[T])
 }
@@ -0,0 +1,106 @@
+package set
+
+import "github.com/mikhasd/fluent/iterator"
+
+// The functions in this file implement the set algebra operations on top of
+// the Set interface, so they work across different Set implementations.
+// Operations producing a new set populate the provided empty `result` set.
+
+func smallerFirst[T any](a, b Set[T]) (Set[T], Set[T]) {
+	if b.Size() < a.Size() {
+		return b, a
+	}
+	return a, b
+}
+
+func filter[T any](s Set[T], condition func(T) bool, result Set[T]) Set[T] {
+	s.ForEach(func(el T) {
+		if condition(el) {
+			result.Add(el)
+		}
+	})
+	return result
+}
+
+func union[T any](a, b Set[T], result Set[T]) Set[T] {
+	result.AddAll(a)
+	result.AddAll(b)
+	return result
+}
+
+func intersection[T any](a, b Set[T], result Set[T]) Set[T] {
+	small, large := smallerFirst(a, b)
+	small.ForEach(func(el T) {
+		if large.Contains(el) {
+			result.Add(el)
+		}
+	})
+	return result
+}
+
+func difference[T any](a, b Set[T], result Set[T]) Set[T] {
+	if b.Size() < a.Size() {
+		result.AddAll(a)
+		result.RemoveAll(b)
+		return result
+	}
+	a.ForEach(func(el T) {
+		if !b.Contains(el) {
+			result.Add(el)
+		}
+	})
+	return result
+}
+
+func symmetricDifference[T any](a, b Set[T], result Set[T]) Set[T] {
+	a.ForEach(func(el T) {
+		if !b.Contains(el) {
+			result.Add(el)
+		}
+	})
+	b.ForEach(func(el T) {
+		if !a.Contains(el) {
+			result.Add(el)
+		}
+	})
+	return result
+}
+
+func isSubset[T any](a, b Set[T]) bool {
+	if a.Size() > b.Size() {
+		return false
+	}
+	return b.ContainsAll(a)
+}
+
+func isDisjoint[T any](a, b Set[T]) bool {
+	small, large := smallerFirst(a, b)
+	it := small.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		if large.Contains(o.Get()) {
+			return false
+		}
+	}
+	return true
+}
+
+func equal[T any](a, b Set[T]) bool {
+	return a.Size() == b.Size() && b.ContainsAll(a)
+}
+
+func retainAll[T any](s, other Set[T]) {
+	var removed []T
+	s.ForEach(func(el T) {
+		if !other.Contains(el) {
+			removed = append(removed, el)
+		}
+	})
+	s.RemoveAll(iterator.ArrayIterable(removed))
+}
+
+func removeAll[T any](s Set[T], iter iterator.Iterable[T]) {
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		s.Remove(o.Get())
+	}
+}
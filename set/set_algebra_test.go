@@ -0,0 +1,126 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+func elements[T any](s Set[T]) []T {
+	var out []T
+	s.ForEach(func(el T) {
+		out = append(out, el)
+	})
+	return out
+}
+
+// byTens is a set of integers keyed by a different type than the values,
+// used to exercise the operations across Set implementations.
+func byTens(values ...int) Set[int] {
+	s := WithSizeAndHasher(len(values), func(v int) int64 {
+		return int64(v) * 10
+	})
+	s.AddAll(iterator.ArrayIterable(values))
+	return s
+}
+
+func Test_Set_Union(t *testing.T) {
+	a := FromArray([]int{1, 2, 3})
+	b := FromArray([]int{3, 4})
+
+	assert.ElementsMatch(t, []int{1, 2, 3, 4}, elements(a.Union(b)))
+	assert.ElementsMatch(t, []int{1, 2, 3, 4}, elements(b.Union(a)))
+	assert.ElementsMatch(t, []int{1, 2, 3, 4}, elements(a.Union(byTens(3, 4))), "cross")
+	assert.Equal(t, 3, a.Size(), "unchanged")
+}
+
+func Test_Set_Intersection(t *testing.T) {
+	a := FromArray([]int{1, 2, 3, 4, 5})
+	b := FromArray([]int{4, 5, 6})
+
+	assert.ElementsMatch(t, []int{4, 5}, elements(a.Intersection(b)))
+	assert.ElementsMatch(t, []int{4, 5}, elements(b.Intersection(a)))
+	assert.ElementsMatch(t, []int{4, 5}, elements(a.Intersection(byTens(4, 5, 6))), "cross")
+	assert.True(t, a.Intersection(New[int]()).Empty(), "empty")
+}
+
+func Test_Set_Difference(t *testing.T) {
+	a := FromArray([]int{1, 2, 3, 4, 5})
+	b := FromArray([]int{4, 5, 6})
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, elements(a.Difference(b)))
+	assert.ElementsMatch(t, []int{6}, elements(b.Difference(a)))
+	assert.ElementsMatch(t, []int{1, 2, 3}, elements(a.Difference(byTens(4, 5, 6))), "cross")
+}
+
+func Test_Set_SymmetricDifference(t *testing.T) {
+	a := FromArray([]int{1, 2, 3, 4})
+	b := FromArray([]int{3, 4, 5})
+
+	assert.ElementsMatch(t, []int{1, 2, 5}, elements(a.SymmetricDifference(b)))
+	assert.ElementsMatch(t, []int{1, 2, 5}, elements(b.SymmetricDifference(a)))
+}
+
+func Test_Set_IsSubsetOf(t *testing.T) {
+	a := FromArray([]int{1, 2})
+	b := FromArray([]int{1, 2, 3})
+
+	assert.True(t, a.IsSubsetOf(b))
+	assert.False(t, b.IsSubsetOf(a))
+	assert.True(t, a.IsSubsetOf(a), "self")
+	assert.True(t, New[int]().IsSubsetOf(a), "empty")
+	assert.False(t, FromArray([]int{1, 4}).IsSubsetOf(b), "missing")
+	assert.True(t, byTens(1, 2).IsSubsetOf(b), "cross")
+}
+
+func Test_Set_IsSupersetOf(t *testing.T) {
+	a := FromArray([]int{1, 2})
+	b := FromArray([]int{1, 2, 3})
+
+	assert.True(t, b.IsSupersetOf(a))
+	assert.False(t, a.IsSupersetOf(b))
+}
+
+func Test_Set_IsDisjoint(t *testing.T) {
+	a := FromArray([]int{1, 2})
+
+	assert.True(t, a.IsDisjoint(FromArray([]int{3, 4, 5})))
+	assert.False(t, a.IsDisjoint(FromArray([]int{2, 3, 4})))
+	assert.True(t, a.IsDisjoint(New[int]()), "empty")
+}
+
+func Test_Set_Equal(t *testing.T) {
+	a := FromArray([]int{1, 2, 3})
+
+	assert.True(t, a.Equal(FromArray([]int{3, 2, 1})))
+	assert.True(t, a.Equal(byTens(1, 2, 3)), "cross")
+	assert.False(t, a.Equal(FromArray([]int{1, 2})), "size")
+	assert.False(t, a.Equal(FromArray([]int{1, 2, 4})), "content")
+}
+
+func Test_Set_RetainAll(t *testing.T) {
+	a := FromArray([]int{1, 2, 3, 4})
+	a.RetainAll(FromArray([]int{2, 4, 6}))
+
+	assert.ElementsMatch(t, []int{2, 4}, elements(a))
+
+	b := byTens(1, 2, 3, 4)
+	b.RetainAll(FromArray([]int{1, 3}))
+
+	assert.ElementsMatch(t, []int{1, 3}, elements(b), "cross")
+}
+
+func Test_Set_RemoveAll(t *testing.T) {
+	a := FromArray([]int{1, 2, 3, 4})
+	a.RemoveAll(iterator.ArrayIterable([]int{2, 4, 6}))
+
+	assert.ElementsMatch(t, []int{1, 3}, elements(a))
+}
+
+func Test_retainAll(t *testing.T) {
+	a := FromArray([]int{1, 2, 3, 4})
+	retainAll(a, FromArray([]int{1, 2}))
+
+	assert.ElementsMatch(t, []int{1, 2}, elements(a))
+}
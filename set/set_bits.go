@@ -0,0 +1,709 @@
+package set
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/stream"
+)
+
+// BitSet is a Set of non-negative integers storing one bit per possible
+// element, well suited for dense sets of small integers.
+//
+// A BitSet can be switched to a run-length compressed mode, storing ranges of
+// consecutive elements instead of bits, which is more compact when the
+// elements are clustered in a few ranges spread over a large domain.
+//
+// Adding a negative integer to a BitSet panics.
+type BitSet struct {
+	words      []uint64
+	runs       []bitRun
+	compressed bool
+}
+
+// bitRun is a range of consecutive elements, from `start`, inclusive, to
+// `end`, exclusive.
+type bitRun struct {
+	start, end int
+}
+
+const wordBits = 64
+
+// runIndex returns the index of the first run ending after `v`.
+func (b *BitSet) runIndex(v int) int {
+	return sort.Search(len(b.runs), func(i int) bool {
+		return b.runs[i].end > v
+	})
+}
+
+func (b *BitSet) Contains(v int) bool {
+	if v < 0 {
+		return false
+	}
+	if b.compressed {
+		i := b.runIndex(v)
+		return i < len(b.runs) && b.runs[i].start <= v
+	}
+	w := v / wordBits
+	return w < len(b.words) && b.words[w]&(1<<(v%wordBits)) != 0
+}
+
+func (b *BitSet) ContainsAll(iter iterator.Iterable[int]) bool {
+	if other, ok := iter.(*BitSet); ok {
+		return other.IsSubsetOf(b)
+	}
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		if !b.Contains(o.Get()) {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *BitSet) Add(v int) bool {
+	if v < 0 {
+		panic("negative bit index")
+	}
+	if b.Contains(v) {
+		return false
+	}
+	if b.compressed {
+		b.addRun(v)
+		return true
+	}
+	w := v / wordBits
+	if w >= len(b.words) {
+		b.grow(w + 1)
+	}
+	b.words[w] |= 1 << (v % wordBits)
+	return true
+}
+
+func (b *BitSet) grow(words int) {
+	if words <= cap(b.words) {
+		b.words = b.words[:words]
+		return
+	}
+	grown := make([]uint64, words, 2*words)
+	copy(grown, b.words)
+	b.words = grown
+}
+
+func (b *BitSet) addRun(v int) {
+	i := sort.Search(len(b.runs), func(i int) bool {
+		return b.runs[i].end >= v
+	})
+	switch {
+	case i < len(b.runs) && b.runs[i].start <= v && v < b.runs[i].end:
+		// already present
+	case i < len(b.runs) && b.runs[i].end == v:
+		b.runs[i].end++
+		if next := i + 1; next < len(b.runs) && b.runs[next].start == v+1 {
+			b.runs[i].end = b.runs[next].end
+			b.runs = append(b.runs[:next], b.runs[next+1:]...)
+		}
+	case i < len(b.runs) && b.runs[i].start == v+1:
+		b.runs[i].start = v
+	default:
+		b.runs = append(b.runs, bitRun{})
+		copy(b.runs[i+1:], b.runs[i:])
+		b.runs[i] = bitRun{v, v + 1}
+	}
+}
+
+func (b *BitSet) AddAll(iter iterator.Iterable[int]) {
+	if other, ok := iter.(*BitSet); ok {
+		b.assign(b.combine(other, or))
+		return
+	}
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		b.Add(o.Get())
+	}
+}
+
+func (b *BitSet) Remove(v int) bool {
+	if !b.Contains(v) {
+		return false
+	}
+	if b.compressed {
+		b.removeRun(v)
+		return true
+	}
+	b.words[v/wordBits] &^= 1 << (v % wordBits)
+	return true
+}
+
+// Clear removes every element from the set, keeping its mode.
+func (b *BitSet) Clear() {
+	b.words = nil
+	b.runs = nil
+}
+
+func (b *BitSet) Clone() Set[int] {
+	return &BitSet{
+		words:      append([]uint64(nil), b.words...),
+		runs:       append([]bitRun(nil), b.runs...),
+		compressed: b.compressed,
+	}
+}
+
+func (b *BitSet) Filter(condition func(int) bool) Set[int] {
+	return filter[int](b, condition, b.empty())
+}
+
+func (b *BitSet) ToSlice() []int {
+	return toSlice[int](b)
+}
+
+func (b *BitSet) Stream() stream.Stream[int] {
+	return stream.FromIterable[int](b)
+}
+
+func (b *BitSet) String() string {
+	return toString(toSlice[int](b))
+}
+
+func (b *BitSet) removeRun(v int) {
+	i := b.runIndex(v)
+	if i == len(b.runs) || b.runs[i].start > v {
+		return
+	}
+	run := b.runs[i]
+	switch {
+	case run.start == v && run.end == v+1:
+		b.runs = append(b.runs[:i], b.runs[i+1:]...)
+	case run.start == v:
+		b.runs[i].start++
+	case run.end == v+1:
+		b.runs[i].end--
+	default:
+		b.runs = append(b.runs, bitRun{})
+		copy(b.runs[i+1:], b.runs[i:])
+		b.runs[i].end = v
+		b.runs[i+1].start = v + 1
+	}
+}
+
+// nextSetBit returns the lowest element greater than or equal to `from`, or
+// -1 if there is none.
+func (b *BitSet) nextSetBit(from int) int {
+	if from < 0 {
+		from = 0
+	}
+	if b.compressed {
+		i := b.runIndex(from)
+		if i == len(b.runs) {
+			return -1
+		}
+		if b.runs[i].start > from {
+			return b.runs[i].start
+		}
+		return from
+	}
+	w := from / wordBits
+	if w >= len(b.words) {
+		return -1
+	}
+	word := b.words[w] & (^uint64(0) << (from % wordBits))
+	for {
+		if word != 0 {
+			return w*wordBits + bits.TrailingZeros64(word)
+		}
+		w++
+		if w == len(b.words) {
+			return -1
+		}
+		word = b.words[w]
+	}
+}
+
+// nextClearBit returns the lowest integer greater than or equal to `from`
+// which is not an element of the set.
+func (b *BitSet) nextClearBit(from int) int {
+	if from < 0 {
+		from = 0
+	}
+	if b.compressed {
+		i := b.runIndex(from)
+		if i < len(b.runs) && b.runs[i].start <= from {
+			return b.runs[i].end
+		}
+		return from
+	}
+	w := from / wordBits
+	if w >= len(b.words) {
+		return from
+	}
+	word := ^b.words[w] & (^uint64(0) << (from % wordBits))
+	for {
+		if word != 0 {
+			return w*wordBits + bits.TrailingZeros64(word)
+		}
+		w++
+		if w == len(b.words) {
+			return w * wordBits
+		}
+		word = ^b.words[w]
+	}
+}
+
+// NextSetBit returns the lowest element of the set greater than or equal to
+// `from`, if any.
+func (b *BitSet) NextSetBit(from int) fluent.Option[int] {
+	if next := b.nextSetBit(from); next >= 0 {
+		return fluent.Present(next)
+	}
+	return fluent.Empty[int]()
+}
+
+type bitSetIterator struct {
+	set  *BitSet
+	next int
+	size int
+}
+
+func (it *bitSetIterator) Next() fluent.Option[int] {
+	if it.next < 0 {
+		return fluent.Empty[int]()
+	}
+	v := it.set.nextSetBit(it.next)
+	if v < 0 {
+		it.next = -1
+		return fluent.Empty[int]()
+	}
+	it.next = v + 1
+	return fluent.Present(v)
+}
+
+// Implements iterator.Sized interface
+func (it *bitSetIterator) Size() fluent.Option[int] {
+	return fluent.Present(it.size)
+}
+
+// Iterator returns an iterator over the elements of the set in ascending
+// order.
+func (b *BitSet) Iterator() iterator.Iterator[int] {
+	return &bitSetIterator{
+		set:  b,
+		size: b.Size(),
+	}
+}
+
+// ForEach calls `fn` with each element of the set in ascending order.
+func (b *BitSet) ForEach(fn func(int)) {
+	if b.compressed {
+		for _, run := range b.runs {
+			for v := run.start; v < run.end; v++ {
+				fn(v)
+			}
+		}
+		return
+	}
+	for w, word := range b.words {
+		for word != 0 {
+			fn(w*wordBits + bits.TrailingZeros64(word))
+			word &= word - 1
+		}
+	}
+}
+
+func (b *BitSet) Empty() bool {
+	if b.compressed {
+		return len(b.runs) == 0
+	}
+	for _, word := range b.words {
+		if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *BitSet) Size() int {
+	size := 0
+	if b.compressed {
+		for _, run := range b.runs {
+			size += run.end - run.start
+		}
+		return size
+	}
+	for _, word := range b.words {
+		size += bits.OnesCount64(word)
+	}
+	return size
+}
+
+// Compressed returns true if the set is in run-length compressed mode.
+func (b *BitSet) Compressed() bool {
+	return b.compressed
+}
+
+// Compress switches the set to run-length compressed mode.
+func (b *BitSet) Compress() {
+	if !b.compressed {
+		b.runs = b.toRuns()
+		b.words = nil
+		b.compressed = true
+	}
+}
+
+// Expand switches the set back from run-length compressed mode to one bit per
+// element.
+func (b *BitSet) Expand() {
+	if b.compressed {
+		b.words = wordsOf(b.runs)
+		b.runs = nil
+		b.compressed = false
+	}
+}
+
+func (b *BitSet) toRuns() []bitRun {
+	if b.compressed {
+		return b.runs
+	}
+	var runs []bitRun
+	for start := b.nextSetBit(0); start >= 0; {
+		end := b.nextClearBit(start)
+		runs = append(runs, bitRun{start, end})
+		start = b.nextSetBit(end)
+	}
+	return runs
+}
+
+func wordsOf(runs []bitRun) []uint64 {
+	if len(runs) == 0 {
+		return nil
+	}
+	words := make([]uint64, (runs[len(runs)-1].end+wordBits-1)/wordBits)
+	for _, run := range runs {
+		for v := run.start; v < run.end; {
+			w, offset := v/wordBits, v%wordBits
+			count := wordBits - offset
+			if remaining := run.end - v; remaining < count {
+				count = remaining
+			}
+			mask := ^uint64(0)
+			if count < wordBits {
+				mask = (1<<count - 1) << offset
+			}
+			words[w] |= mask
+			v += count
+		}
+	}
+	return words
+}
+
+// Word and run level operations
+
+type bitOp func(a, b uint64) uint64
+
+func or(a, b uint64) uint64     { return a | b }
+func and(a, b uint64) uint64    { return a & b }
+func andNot(a, b uint64) uint64 { return a &^ b }
+func xor(a, b uint64) uint64    { return a ^ b }
+
+// combine computes a new BitSet applying the operation to the elements of
+// both sets. The result has the same mode as this set.
+func (b *BitSet) combine(other *BitSet, op bitOp) *BitSet {
+	if !b.compressed && !other.compressed {
+		size := len(b.words)
+		if len(other.words) > size {
+			size = len(other.words)
+		}
+		words := make([]uint64, size)
+		for i := range words {
+			var x, y uint64
+			if i < len(b.words) {
+				x = b.words[i]
+			}
+			if i < len(other.words) {
+				y = other.words[i]
+			}
+			words[i] = op(x, y)
+		}
+		return &BitSet{words: trim(words)}
+	}
+	result := &BitSet{
+		runs:       mergeRuns(b.toRuns(), other.toRuns(), op),
+		compressed: true,
+	}
+	if !b.compressed {
+		result.Expand()
+	}
+	return result
+}
+
+func (b *BitSet) assign(other *BitSet) {
+	*b = *other
+}
+
+func trim(words []uint64) []uint64 {
+	size := len(words)
+	for size > 0 && words[size-1] == 0 {
+		size--
+	}
+	return words[:size]
+}
+
+// mergeRuns sweeps the boundaries of both run lists, keeping the ranges where
+// the operation, applied to the membership of each list, holds.
+func mergeRuns(a, b []bitRun, op bitOp) []bitRun {
+	var result []bitRun
+	var i, j int
+	pos := 0
+	for i < len(a) || j < len(b) {
+		inA, nextA := runState(a, i, pos)
+		inB, nextB := runState(b, j, pos)
+		next := nextA
+		if nextB < next {
+			next = nextB
+		}
+		if op(inA, inB) != 0 {
+			if last := len(result) - 1; last >= 0 && result[last].end == pos {
+				result[last].end = next
+			} else {
+				result = append(result, bitRun{pos, next})
+			}
+		}
+		pos = next
+		for i < len(a) && a[i].end <= pos {
+			i++
+		}
+		for j < len(b) && b[j].end <= pos {
+			j++
+		}
+	}
+	return result
+}
+
+// runState returns whether `pos` is covered by the run at index `i`, encoded
+// as a single bit, and the next position where the coverage changes.
+func runState(runs []bitRun, i, pos int) (uint64, int) {
+	const unbounded = int(^uint(0) >> 1)
+	if i == len(runs) {
+		return 0, unbounded
+	}
+	if runs[i].start <= pos {
+		return 1, runs[i].end
+	}
+	return 0, runs[i].start
+}
+
+func (b *BitSet) Union(other Set[int]) Set[int] {
+	if o, ok := other.(*BitSet); ok {
+		return b.combine(o, or)
+	}
+	return union[int](b, other, b.empty())
+}
+
+func (b *BitSet) Intersection(other Set[int]) Set[int] {
+	if o, ok := other.(*BitSet); ok {
+		return b.combine(o, and)
+	}
+	return intersection[int](b, other, b.empty())
+}
+
+func (b *BitSet) Difference(other Set[int]) Set[int] {
+	if o, ok := other.(*BitSet); ok {
+		return b.combine(o, andNot)
+	}
+	return difference[int](b, other, b.empty())
+}
+
+func (b *BitSet) SymmetricDifference(other Set[int]) Set[int] {
+	if o, ok := other.(*BitSet); ok {
+		return b.combine(o, xor)
+	}
+	return symmetricDifference[int](b, other, b.empty())
+}
+
+func (b *BitSet) IsSubsetOf(other Set[int]) bool {
+	if o, ok := other.(*BitSet); ok {
+		return b.combine(o, andNot).Empty()
+	}
+	return isSubset[int](b, other)
+}
+
+func (b *BitSet) IsSupersetOf(other Set[int]) bool {
+	if o, ok := other.(*BitSet); ok {
+		return o.IsSubsetOf(b)
+	}
+	return isSubset[int](other, b)
+}
+
+func (b *BitSet) IsDisjoint(other Set[int]) bool {
+	if o, ok := other.(*BitSet); ok {
+		return b.combine(o, and).Empty()
+	}
+	return isDisjoint[int](b, other)
+}
+
+func (b *BitSet) Equal(other Set[int]) bool {
+	if o, ok := other.(*BitSet); ok {
+		return b.combine(o, xor).Empty()
+	}
+	return equal[int](b, other)
+}
+
+func (b *BitSet) RetainAll(other Set[int]) {
+	if o, ok := other.(*BitSet); ok {
+		b.assign(b.combine(o, and))
+		return
+	}
+	retainAll[int](b, other)
+}
+
+func (b *BitSet) RemoveAll(iter iterator.Iterable[int]) {
+	if o, ok := iter.(*BitSet); ok {
+		b.assign(b.combine(o, andNot))
+		return
+	}
+	removeAll[int](b, iter)
+}
+
+// bitSetVersion identifies the binary encoding of a BitSet.
+const bitSetVersion = 1
+
+// MarshalJSON implements the json.Marshaler interface. The elements are
+// encoded in ascending order.
+func (b *BitSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(toSlice[int](b))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (b *BitSet) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+	elements, err := decodeJSON[int](data)
+	if err != nil {
+		return err
+	}
+	for _, el := range elements {
+		if el < 0 {
+			return errors.New("bitset: negative element")
+		}
+	}
+	replace[int](b, elements)
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+//
+// The set is encoded as its ranges of consecutive elements, each range being
+// the distance from the end of the previous range followed by its length, so
+// the encoding is compact in both modes.
+func (b *BitSet) MarshalBinary() ([]byte, error) {
+	runs := b.toRuns()
+	data := make([]byte, 2+binary.MaxVarintLen64*(1+2*len(runs)))
+	data[0] = bitSetVersion
+	if b.compressed {
+		data[1] = 1
+	}
+	n := 2
+	n += binary.PutUvarint(data[n:], uint64(len(runs)))
+	end := 0
+	for _, run := range runs {
+		n += binary.PutUvarint(data[n:], uint64(run.start-end))
+		n += binary.PutUvarint(data[n:], uint64(run.end-run.start))
+		end = run.end
+	}
+	return data[:n], nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface. The
+// set takes the mode of the encoded set. Encodings of elements greater than
+// math.MaxInt32 are rejected.
+func (b *BitSet) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 {
+		return errors.New("bitset: data too short")
+	}
+	if data[0] != bitSetVersion {
+		return errors.New("bitset: unsupported version")
+	}
+	compressed := data[1] == 1
+	data = data[2:]
+	next := func() (int, error) {
+		v, n := binary.Uvarint(data)
+		if n <= 0 || v > math.MaxInt32 {
+			return 0, errors.New("bitset: invalid data")
+		}
+		data = data[n:]
+		return int(v), nil
+	}
+	count, err := next()
+	if err != nil {
+		return err
+	}
+	var runs []bitRun
+	end := 0
+	for i := 0; i < count; i++ {
+		gap, err := next()
+		if err != nil {
+			return err
+		}
+		length, err := next()
+		if err != nil {
+			return err
+		}
+		if length == 0 || (i > 0 && gap == 0) || gap+length > math.MaxInt32-end {
+			return errors.New("bitset: invalid data")
+		}
+		run := bitRun{end + gap, end + gap + length}
+		runs = append(runs, run)
+		end = run.end
+	}
+	if len(data) > 0 {
+		return errors.New("bitset: invalid data length")
+	}
+	b.compressed = compressed
+	if compressed {
+		b.runs, b.words = runs, nil
+	} else {
+		b.runs, b.words = nil, wordsOf(runs)
+	}
+	return nil
+}
+
+func (b *BitSet) empty() *BitSet {
+	return &BitSet{
+		compressed: b.compressed,
+	}
+}
+
+// NewBitSet creates an empty BitSet.
+func NewBitSet() *BitSet {
+	return &BitSet{}
+}
+
+// BitSetWithCapacity creates an empty BitSet with room for the elements from
+// 0 to `capacity`, exclusive.
+func BitSetWithCapacity(capacity int) *BitSet {
+	return &BitSet{
+		words: make([]uint64, 0, (capacity+wordBits-1)/wordBits),
+	}
+}
+
+// NewCompressedBitSet creates an empty BitSet in run-length compressed mode.
+func NewCompressedBitSet() *BitSet {
+	return &BitSet{
+		compressed: true,
+	}
+}
+
+// BitSetOf creates a BitSet with the given elements.
+func BitSetOf(elements ...int) *BitSet {
+	b := NewBitSet()
+	for _, el := range elements {
+		b.Add(el)
+	}
+	return b
+}
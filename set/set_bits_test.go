@@ -0,0 +1,247 @@
+package set
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ Set[int] = NewBitSet()
+
+func Test_BitSet_Add(t *testing.T) {
+	b := NewBitSet()
+	b.Add(0)
+	b.Add(63)
+	b.Add(64)
+	b.Add(1000)
+	b.Add(64)
+
+	assert.Equal(t, 4, b.Size(), "size")
+	assert.Equal(t, []int{0, 63, 64, 1000}, drain(b.Iterator()), "elements")
+	assert.True(t, b.Contains(63), "contains")
+	assert.False(t, b.Contains(62), "contains")
+	assert.False(t, b.Contains(5000), "out of range")
+	assert.False(t, b.Contains(-1), "negative")
+	assert.Panics(t, func() { b.Add(-1) }, "negative")
+}
+
+func Test_BitSet_Remove(t *testing.T) {
+	b := BitSetOf(1, 2, 3, 200)
+	b.Remove(2)
+	b.Remove(200)
+	b.Remove(5000)
+	b.Remove(-1)
+
+	assert.Equal(t, []int{1, 3}, drain(b.Iterator()))
+	b.Remove(1)
+	b.Remove(3)
+	assert.True(t, b.Empty(), "empty")
+}
+
+func Test_BitSet_NextSetBit(t *testing.T) {
+	b := BitSetOf(3, 64, 130)
+
+	assert.Equal(t, fluent.Present(3), b.NextSetBit(-5))
+	assert.Equal(t, fluent.Present(3), b.NextSetBit(3))
+	assert.Equal(t, fluent.Present(64), b.NextSetBit(4))
+	assert.Equal(t, fluent.Present(130), b.NextSetBit(65))
+	assert.False(t, b.NextSetBit(131).IsPresent())
+	assert.False(t, b.NextSetBit(1000).IsPresent())
+
+	b.Compress()
+	assert.Equal(t, fluent.Present(64), b.NextSetBit(4), "compressed")
+	assert.False(t, b.NextSetBit(131).IsPresent(), "compressed")
+}
+
+func Test_BitSet_ForEach(t *testing.T) {
+	b := BitSetOf(5, 1, 70, 3)
+
+	assert.Equal(t, []int{1, 3, 5, 70}, elements[int](b))
+	b.Compress()
+	assert.Equal(t, []int{1, 3, 5, 70}, elements[int](b), "compressed")
+}
+
+func Test_BitSet_Iterator_Size(t *testing.T) {
+	b := BitSetOf(5, 1, 70, 3)
+
+	assert.Equal(t, fluent.Present(4), iterator.Size(b.Iterator()))
+	it := b.Iterator()
+	drain(it)
+	assert.False(t, it.Next().IsPresent(), "exhausted")
+}
+
+func Test_BitSet_Compress(t *testing.T) {
+	b := NewBitSet()
+	for v := 100; v < 300; v++ {
+		b.Add(v)
+	}
+	b.Add(1000)
+	b.Compress()
+
+	assert.True(t, b.Compressed(), "compressed")
+	assert.Equal(t, []bitRun{{100, 300}, {1000, 1001}}, b.runs, "runs")
+	assert.Nil(t, b.words, "words")
+	assert.Equal(t, 201, b.Size(), "size")
+
+	b.Expand()
+	assert.False(t, b.Compressed(), "expanded")
+	assert.Equal(t, 201, b.Size(), "size")
+	assert.True(t, b.Contains(299), "contains")
+	assert.False(t, b.Contains(300), "contains")
+}
+
+func Test_BitSet_compressed_runs(t *testing.T) {
+	b := NewCompressedBitSet()
+	b.Add(5)
+	b.Add(7)
+	assert.Equal(t, []bitRun{{5, 6}, {7, 8}}, b.runs, "separate")
+	b.Add(6)
+	assert.Equal(t, []bitRun{{5, 8}}, b.runs, "merged")
+	b.Add(4)
+	b.Add(8)
+	assert.Equal(t, []bitRun{{4, 9}}, b.runs, "extended")
+	b.Remove(6)
+	assert.Equal(t, []bitRun{{4, 6}, {7, 9}}, b.runs, "split")
+	b.Remove(4)
+	b.Remove(8)
+	assert.Equal(t, []bitRun{{5, 6}, {7, 8}}, b.runs, "shrunk")
+	b.Remove(5)
+	assert.Equal(t, []bitRun{{7, 8}}, b.runs, "removed")
+	b.Add(1 << 40)
+	assert.Equal(t, 2, b.Size(), "sparse")
+}
+
+func Test_BitSet_random(t *testing.T) {
+	for _, compressed := range []bool{false, true} {
+		r := rand.New(rand.NewSource(3))
+		b := NewBitSet()
+		if compressed {
+			b = NewCompressedBitSet()
+		}
+		expected := make(map[int]bool)
+		for i := 0; i < 5000; i++ {
+			v := r.Intn(400)
+			if r.Intn(3) == 0 {
+				b.Remove(v)
+				delete(expected, v)
+			} else {
+				b.Add(v)
+				expected[v] = true
+			}
+		}
+
+		var keys []int
+		for k := range expected {
+			keys = append(keys, k)
+		}
+		sort.Ints(keys)
+		assert.Equal(t, keys, drain(b.Iterator()), "compressed %v", compressed)
+		assert.Equal(t, len(keys), b.Size(), "compressed %v", compressed)
+		for v := 0; v < 400; v++ {
+			assert.Equal(t, expected[v], b.Contains(v), "compressed %v: %d", compressed, v)
+		}
+	}
+}
+
+func bitSetModes(values ...int) map[string]*BitSet {
+	plain := BitSetOf(values...)
+	compressed := BitSetOf(values...)
+	compressed.Compress()
+	return map[string]*BitSet{"plain": plain, "compressed": compressed}
+}
+
+func Test_BitSet_algebra(t *testing.T) {
+	for nameA, a := range bitSetModes(1, 2, 3, 4, 100, 101) {
+		for nameB, b := range bitSetModes(3, 4, 5, 101, 300) {
+			name := nameA + "/" + nameB
+			assert.Equal(t, []int{1, 2, 3, 4, 5, 100, 101, 300}, elements(a.Union(b)), "union %s", name)
+			assert.Equal(t, []int{3, 4, 101}, elements(a.Intersection(b)), "intersection %s", name)
+			assert.Equal(t, []int{1, 2, 100}, elements(a.Difference(b)), "difference %s", name)
+			assert.Equal(t, []int{1, 2, 5, 100, 300}, elements(a.SymmetricDifference(b)), "symmetric %s", name)
+			assert.False(t, a.IsSubsetOf(b), "subset %s", name)
+			assert.True(t, a.Intersection(b).IsSubsetOf(b), "subset %s", name)
+			assert.True(t, b.IsSupersetOf(a.Intersection(b)), "superset %s", name)
+			assert.False(t, a.IsDisjoint(b), "disjoint %s", name)
+			assert.True(t, a.Difference(b).IsDisjoint(b), "disjoint %s", name)
+			assert.False(t, a.Equal(b), "equal %s", name)
+			assert.True(t, a.Union(b).Equal(b.Union(a)), "equal %s", name)
+			assert.False(t, a.ContainsAll(b), "contains all %s", name)
+			assert.Equal(t, a.Compressed(), a.Union(b).(*BitSet).Compressed(), "mode %s", name)
+		}
+	}
+}
+
+func Test_BitSet_algebra_otherSets(t *testing.T) {
+	a := BitSetOf(1, 2, 3, 4)
+	b := FromArray([]int{3, 4, 5})
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, elements(a.Union(b)), "union")
+	assert.Equal(t, []int{3, 4}, elements(a.Intersection(b)), "intersection")
+	assert.Equal(t, []int{1, 2}, elements(a.Difference(b)), "difference")
+	assert.Equal(t, []int{1, 2, 5}, elements(a.SymmetricDifference(b)), "symmetric")
+	assert.True(t, a.IsSubsetOf(FromArray([]int{1, 2, 3, 4, 5})), "subset")
+	assert.True(t, a.IsSupersetOf(FromArray([]int{1, 2})), "superset")
+	assert.True(t, a.IsDisjoint(FromArray([]int{7, 8})), "disjoint")
+	assert.True(t, a.Equal(FromArray([]int{1, 2, 3, 4})), "equal")
+	assert.True(t, a.ContainsAll(iterator.ArrayIterable([]int{1, 4})), "contains all")
+}
+
+func Test_BitSet_inPlace(t *testing.T) {
+	for name, a := range bitSetModes(1, 2, 3, 4, 200) {
+		a.AddAll(BitSetOf(5, 6))
+		a.AddAll(iterator.ArrayIterable([]int{7}))
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 200}, elements[int](a), "add all %s", name)
+
+		a.RetainAll(BitSetOf(2, 3, 4, 5, 6, 7, 200))
+		a.RetainAll(FromArray([]int{3, 4, 5, 6, 7, 200}))
+		assert.Equal(t, []int{3, 4, 5, 6, 7, 200}, elements[int](a), "retain %s", name)
+
+		a.RemoveAll(BitSetOf(3, 200))
+		a.RemoveAll(iterator.ArrayIterable([]int{7}))
+		assert.Equal(t, []int{4, 5, 6}, elements[int](a), "remove %s", name)
+	}
+}
+
+func Test_BitSetWithCapacity(t *testing.T) {
+	b := BitSetWithCapacity(1000)
+	assert.True(t, b.Empty(), "empty")
+	assert.Equal(t, 16, cap(b.words), "capacity")
+
+	b.Add(999)
+	assert.Equal(t, 16, cap(b.words), "no allocation")
+}
+
+func Test_wordsOf(t *testing.T) {
+	words := wordsOf([]bitRun{{0, 64}, {70, 72}, {128, 129}})
+
+	assert.Equal(t, []uint64{^uint64(0), 0b11 << 6, 1}, words)
+	assert.Nil(t, wordsOf(nil))
+}
+
+func Benchmark_BitSet_Union(b *testing.B) {
+	x, y := NewBitSet(), NewBitSet()
+	for i := 0; i < 100000; i += 2 {
+		x.Add(i)
+		y.Add(i + 1)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Union(y)
+	}
+}
+
+func Benchmark_mapSet_Union(b *testing.B) {
+	x, y := New[int](), New[int]()
+	for i := 0; i < 100000; i += 2 {
+		x.Add(i)
+		y.Add(i + 1)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Union(y)
+	}
+}
@@ -0,0 +1,298 @@
+package set
+
+import (
+	"encoding/json"
+	"runtime"
+	"sync"
+
+	"github.com/mikhasd/fluent/internal/hash"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/stream"
+)
+
+// Concurrent is a Set safe for use by multiple goroutines.
+//
+// Elements are spread across shards, each guarded by its own lock, so
+// goroutines working on elements of different shards do not contend with
+// each other. Operations over the whole set, such as Iterator, Size or
+// ForEach, work on a consistent snapshot taken while holding every shard
+// lock.
+type Concurrent[T comparable] struct {
+	shards []concurrentShard[T]
+	hasher func(T) uint64
+}
+
+type concurrentShard[T comparable] struct {
+	lock  sync.RWMutex
+	items map[T]struct{}
+}
+
+func (s *Concurrent[T]) shard(element T) *concurrentShard[T] {
+	return &s.shards[s.hasher(element)%uint64(len(s.shards))]
+}
+
+func (s *Concurrent[T]) Contains(element T) bool {
+	shard := s.shard(element)
+	shard.lock.RLock()
+	defer shard.lock.RUnlock()
+	_, found := shard.items[element]
+	return found
+}
+
+func (s *Concurrent[T]) ContainsAll(iter iterator.Iterable[T]) bool {
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		if !s.Contains(o.Get()) {
+			return false
+		}
+	}
+	return true
+}
+
+// AddIfAbsent atomically adds the element to the set if it is not present,
+// returning true if the element was added.
+func (s *Concurrent[T]) AddIfAbsent(element T) bool {
+	shard := s.shard(element)
+	shard.lock.Lock()
+	defer shard.lock.Unlock()
+	if _, found := shard.items[element]; found {
+		return false
+	}
+	shard.items[element] = struct{}{}
+	return true
+}
+
+// Add is equivalent to AddIfAbsent.
+func (s *Concurrent[T]) Add(element T) bool {
+	return s.AddIfAbsent(element)
+}
+
+func (s *Concurrent[T]) AddAll(iter iterator.Iterable[T]) {
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		s.AddIfAbsent(o.Get())
+	}
+}
+
+// Remove atomically removes the element from the set, returning true if it
+// was present.
+func (s *Concurrent[T]) Remove(element T) bool {
+	shard := s.shard(element)
+	shard.lock.Lock()
+	defer shard.lock.Unlock()
+	if _, found := shard.items[element]; !found {
+		return false
+	}
+	delete(shard.items, element)
+	return true
+}
+
+// Clear removes every element from the set, one shard at a time.
+func (s *Concurrent[T]) Clear() {
+	for i := range s.shards {
+		shard := &s.shards[i]
+		shard.lock.Lock()
+		shard.items = make(map[T]struct{})
+		shard.lock.Unlock()
+	}
+}
+
+// Clone returns a new Concurrent set, with the same number of shards and
+// hasher, holding a snapshot of the elements of this set.
+func (s *Concurrent[T]) Clone() Set[T] {
+	clone := s.empty()
+	for _, el := range s.snapshot() {
+		clone.AddIfAbsent(el)
+	}
+	return clone
+}
+
+// Filter returns a new Concurrent set, with the same number of shards and
+// hasher, holding the elements of a snapshot of this set matching the
+// `condition`.
+func (s *Concurrent[T]) Filter(condition func(T) bool) Set[T] {
+	result := s.empty()
+	for _, el := range s.snapshot() {
+		if condition(el) {
+			result.AddIfAbsent(el)
+		}
+	}
+	return result
+}
+
+// ToSlice returns a snapshot of the elements of the set.
+func (s *Concurrent[T]) ToSlice() []T {
+	return s.snapshot()
+}
+
+// Stream returns a stream over a snapshot of the elements of the set.
+func (s *Concurrent[T]) Stream() stream.Stream[T] {
+	return stream.FromArray(s.snapshot())
+}
+
+func (s *Concurrent[T]) String() string {
+	return toString(sortedSlice[T](s))
+}
+
+// lockAll acquires the read lock of every shard, in order, and returns the
+// function releasing them.
+func (s *Concurrent[T]) lockAll() func() {
+	for i := range s.shards {
+		s.shards[i].lock.RLock()
+	}
+	return func() {
+		for i := range s.shards {
+			s.shards[i].lock.RUnlock()
+		}
+	}
+}
+
+func (s *Concurrent[T]) snapshot() []T {
+	unlock := s.lockAll()
+	defer unlock()
+	size := 0
+	for i := range s.shards {
+		size += len(s.shards[i].items)
+	}
+	items := make([]T, 0, size)
+	for i := range s.shards {
+		for el := range s.shards[i].items {
+			items = append(items, el)
+		}
+	}
+	return items
+}
+
+// Iterator returns an iterator over a snapshot of the set elements, not
+// affected by later modifications.
+func (s *Concurrent[T]) Iterator() iterator.Iterator[T] {
+	return iterator.FromArray(s.snapshot())
+}
+
+// ForEach calls `fn` with each element of a snapshot of the set, without
+// holding any lock, so `fn` may modify the set.
+func (s *Concurrent[T]) ForEach(fn func(T)) {
+	for _, el := range s.snapshot() {
+		fn(el)
+	}
+}
+
+func (s *Concurrent[T]) Empty() bool {
+	return s.Size() == 0
+}
+
+func (s *Concurrent[T]) Size() int {
+	unlock := s.lockAll()
+	defer unlock()
+	size := 0
+	for i := range s.shards {
+		size += len(s.shards[i].items)
+	}
+	return size
+}
+
+func (s *Concurrent[T]) empty() *Concurrent[T] {
+	return ConcurrentWithHasher(len(s.shards), s.hasher)
+}
+
+func (s *Concurrent[T]) Union(other Set[T]) Set[T] {
+	return union[T](s, other, s.empty())
+}
+
+func (s *Concurrent[T]) Intersection(other Set[T]) Set[T] {
+	return intersection[T](s, other, s.empty())
+}
+
+func (s *Concurrent[T]) Difference(other Set[T]) Set[T] {
+	return difference[T](s, other, s.empty())
+}
+
+func (s *Concurrent[T]) SymmetricDifference(other Set[T]) Set[T] {
+	return symmetricDifference[T](s, other, s.empty())
+}
+
+func (s *Concurrent[T]) IsSubsetOf(other Set[T]) bool {
+	return isSubset[T](s, other)
+}
+
+func (s *Concurrent[T]) IsSupersetOf(other Set[T]) bool {
+	return isSubset[T](other, s)
+}
+
+func (s *Concurrent[T]) IsDisjoint(other Set[T]) bool {
+	return isDisjoint[T](s, other)
+}
+
+func (s *Concurrent[T]) Equal(other Set[T]) bool {
+	return equal[T](s, other)
+}
+
+func (s *Concurrent[T]) RetainAll(other Set[T]) {
+	retainAll[T](s, other)
+}
+
+func (s *Concurrent[T]) RemoveAll(iter iterator.Iterable[T]) {
+	removeAll[T](s, iter)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s *Concurrent[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(sortedSlice[T](s))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *Concurrent[T]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+	elements, err := decodeJSON[T](data)
+	if err != nil {
+		return err
+	}
+	replace[T](s, elements)
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (s *Concurrent[T]) MarshalBinary() ([]byte, error) {
+	return encodeBinary(sortedSlice[T](s))
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (s *Concurrent[T]) UnmarshalBinary(data []byte) error {
+	elements, err := decodeBinary[T](data)
+	if err != nil {
+		return err
+	}
+	replace[T](s, elements)
+	return nil
+}
+
+// NewConcurrent creates an empty Concurrent set with a number of shards
+// proportional to the number of CPUs.
+func NewConcurrent[T comparable]() *Concurrent[T] {
+	return ConcurrentWithShards[T](4 * runtime.GOMAXPROCS(0))
+}
+
+// ConcurrentWithShards creates an empty Concurrent set with the given number
+// of shards.
+func ConcurrentWithShards[T comparable](shards int) *Concurrent[T] {
+	return ConcurrentWithHasher(shards, hash.Of[T])
+}
+
+// ConcurrentWithHasher creates an empty Concurrent set with the given number
+// of shards, assigning elements to shards with the `hasher` function. Equal
+// elements must produce the same hash.
+func ConcurrentWithHasher[T comparable](shards int, hasher func(T) uint64) *Concurrent[T] {
+	if shards <= 0 {
+		panic("shard count must be positive")
+	}
+	s := &Concurrent[T]{
+		shards: make([]concurrentShard[T], shards),
+		hasher: hasher,
+	}
+	for i := range s.shards {
+		s.shards[i].items = make(map[T]struct{})
+	}
+	return s
+}
@@ -0,0 +1,228 @@
+package set
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ Set[int] = NewConcurrent[int]()
+
+func Test_Concurrent_AddIfAbsent(t *testing.T) {
+	s := NewConcurrent[string]()
+
+	assert.True(t, s.AddIfAbsent("a"), "added")
+	assert.False(t, s.AddIfAbsent("a"), "present")
+	assert.True(t, s.Contains("a"), "contains")
+	assert.Equal(t, 1, s.Size(), "size")
+}
+
+func Test_Concurrent_Remove(t *testing.T) {
+	s := NewConcurrent[int]()
+	s.AddAll(iterator.ArrayIterable([]int{1, 2, 3}))
+
+	s.Remove(2)
+	assert.False(t, s.Contains(2), "removed")
+	assert.Equal(t, 2, s.Size(), "size")
+
+	s.Remove(1)
+	s.Remove(3)
+	assert.True(t, s.Empty(), "empty")
+}
+
+func Test_Concurrent_contention(t *testing.T) {
+	const (
+		workers = 16
+		values  = 2000
+	)
+	s := ConcurrentWithShards[int](8)
+	var added int64
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < values; i++ {
+				v := (i + w*values/workers) % values
+				if s.AddIfAbsent(v) {
+					atomic.AddInt64(&added, 1)
+				}
+				s.Contains(v)
+				if i%100 == 0 {
+					s.Size()
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(values), added, "added once")
+	assert.Equal(t, values, s.Size(), "size")
+}
+
+func Test_Concurrent_contention_remove(t *testing.T) {
+	const workers = 8
+	s := NewConcurrent[int]()
+	var removed int64
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				if s.AddIfAbsent(i) {
+					s.Remove(i)
+					atomic.AddInt64(&removed, 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.True(t, s.Empty(), "empty")
+	assert.GreaterOrEqual(t, removed, int64(1000), "removed")
+}
+
+func Test_Concurrent_snapshot(t *testing.T) {
+	s := NewConcurrent[int]()
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 5000; i++ {
+			s.Add(i)
+		}
+		close(done)
+	}()
+
+	previous := 0
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		default:
+		}
+		it := s.Iterator()
+		size := iterator.Size(it).Get()
+		count := len(drain(it))
+		assert.Equal(t, size, count, "snapshot size")
+		assert.GreaterOrEqual(t, count, previous, "growing")
+		previous = count
+	}
+	wg.Wait()
+
+	assert.Equal(t, 5000, len(drain(s.Iterator())), "final")
+}
+
+func Test_Concurrent_ForEach_modify(t *testing.T) {
+	s := NewConcurrent[int]()
+	s.AddAll(iterator.ArrayIterable([]int{1, 2, 3}))
+
+	s.ForEach(func(v int) {
+		s.Remove(v)
+		s.Add(v * 10)
+	})
+
+	assert.ElementsMatch(t, []int{10, 20, 30}, elements[int](s))
+}
+
+func Test_Concurrent_parallelStream(t *testing.T) {
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = i % 100
+	}
+	seen := NewConcurrent[int]()
+	var unique int64
+
+	stream.FromArray(data).Parallel().ForEach(func(_ int, v int) {
+		if seen.AddIfAbsent(v) {
+			atomic.AddInt64(&unique, 1)
+		}
+	})
+
+	assert.Equal(t, int64(100), unique, "unique")
+	assert.Equal(t, 100, seen.Size(), "size")
+}
+
+func Test_ConcurrentWithShards_invalid(t *testing.T) {
+	assert.Panics(t, func() {
+		ConcurrentWithShards[int](0)
+	})
+}
+
+func Test_Concurrent_algebra(t *testing.T) {
+	a := ConcurrentWithShards[int](3)
+	a.AddAll(iterator.ArrayIterable([]int{1, 2, 3, 4}))
+	b := FromArray([]int{3, 4, 5, 6})
+
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6}, elements(a.Union(b)), "union")
+	assert.ElementsMatch(t, []int{3, 4}, elements(a.Intersection(b)), "intersection")
+	assert.ElementsMatch(t, []int{1, 2}, elements(a.Difference(b)), "difference")
+	assert.ElementsMatch(t, []int{1, 2, 5, 6}, elements(a.SymmetricDifference(b)), "symmetric")
+	assert.True(t, a.IsSubsetOf(FromArray([]int{1, 2, 3, 4, 5})), "subset")
+	assert.True(t, a.IsSupersetOf(FromArray([]int{1, 2})), "superset")
+	assert.True(t, a.IsDisjoint(FromArray([]int{7, 8})), "disjoint")
+	assert.True(t, a.Equal(FromArray([]int{1, 2, 3, 4})), "equal")
+	assert.True(t, a.ContainsAll(iterator.ArrayIterable([]int{1, 4})), "contains all")
+	assert.Equal(t, fluent.Present(4), iterator.Size(a.Iterator()), "size")
+
+	a.RetainAll(b)
+	assert.ElementsMatch(t, []int{3, 4}, elements[int](a), "retain")
+
+	a.RemoveAll(iterator.ArrayIterable([]int{4}))
+	assert.ElementsMatch(t, []int{3}, elements[int](a), "remove")
+}
+
+func Test_Concurrent_Add_race(t *testing.T) {
+	s := NewConcurrent[int]()
+	var added int32
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				if s.Add(i) {
+					atomic.AddInt32(&added, 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1000), added, "each element added once")
+	assert.Equal(t, 1000, s.Size())
+}
+
+type hashTestPoint struct {
+	X, Y float64
+}
+
+func Test_Concurrent_pointer(t *testing.T) {
+	s := NewConcurrent[*hashTestPoint]()
+	p := &hashTestPoint{1, 2}
+	s.Add(p)
+	p.X = 2
+
+	assert.True(t, s.Contains(p), "found after the pointee changed")
+	assert.True(t, s.Remove(p), "removed")
+	assert.True(t, s.Empty())
+}
+
+func Test_Concurrent_signedZero(t *testing.T) {
+	s := NewConcurrent[hashTestPoint]()
+
+	assert.True(t, s.Add(hashTestPoint{0, 1}))
+	assert.False(t, s.Add(hashTestPoint{math.Copysign(0, -1), 1}), "equal element")
+	assert.Equal(t, 1, s.Size())
+}
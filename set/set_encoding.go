@@ -0,0 +1,106 @@
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/mikhasd/fluent"
+)
+
+// The set implementations are encoded as an array of their elements, both in
+// JSON and in binary form. Sets without an intrinsic order sort their elements
+// first when they are of an ordered kind (integers, floats and strings), so
+// equal sets produce the same encoding.
+//
+// Decoding into a set replaces its elements, keeping its configuration, such
+// as its hasher or comparator. To decode into a Set[T] variable, it must hold
+// a set before decoding:
+//
+//	var s set.Set[string] = set.New[string]()
+//	err := json.Unmarshal(data, &s)
+
+// FromJSON creates a set with the elements of a JSON array.
+func FromJSON[T comparable](data []byte) fluent.Result[Set[T]] {
+	s := New[T]()
+	if err := json.Unmarshal(data, s); err != nil {
+		return fluent.Err[Set[T]](err)
+	}
+	return fluent.Ok(s)
+}
+
+// toSlice returns the elements of the set in iteration order. The result is
+// never nil, so empty sets are encoded as an empty array.
+func toSlice[T any](s ReadOnly[T]) []T {
+	elements := make([]T, 0, s.Size())
+	s.ForEach(func(el T) {
+		elements = append(elements, el)
+	})
+	return elements
+}
+
+// sortedSlice returns the elements of the set, sorted if they are of an
+// ordered kind, or in iteration order otherwise.
+func sortedSlice[T any](s ReadOnly[T]) []T {
+	elements := toSlice(s)
+	values := reflect.ValueOf(elements)
+	var less func(a, b reflect.Value) bool
+	switch values.Type().Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		less = func(a, b reflect.Value) bool { return a.Int() < b.Int() }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		less = func(a, b reflect.Value) bool { return a.Uint() < b.Uint() }
+	case reflect.Float32, reflect.Float64:
+		less = func(a, b reflect.Value) bool { return a.Float() < b.Float() }
+	case reflect.String:
+		less = func(a, b reflect.Value) bool { return a.String() < b.String() }
+	default:
+		return elements
+	}
+	sort.Slice(elements, func(i, j int) bool {
+		return less(values.Index(i), values.Index(j))
+	})
+	return elements
+}
+
+// toString formats the elements of the set in the given order.
+func toString[T any](elements []T) string {
+	return fmt.Sprintf("Set%+v", elements)
+}
+
+func isNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
+
+func decodeJSON[T any](data []byte) ([]T, error) {
+	var elements []T
+	err := json.Unmarshal(data, &elements)
+	return elements, err
+}
+
+func encodeBinary[T any](elements []T) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(elements); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeBinary[T any](data []byte) ([]T, error) {
+	var elements []T
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&elements)
+	return elements, err
+}
+
+// replace removes every element of the set and adds the given elements.
+func replace[T any](s Set[T], elements []T) {
+	for _, el := range toSlice[T](s) {
+		s.Remove(el)
+	}
+	for _, el := range elements {
+		s.Add(el)
+	}
+}
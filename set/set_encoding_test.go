@@ -0,0 +1,248 @@
+package set
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type encodingTestPoint struct {
+	X, Y int
+}
+
+func Test_Set_MarshalJSON(t *testing.T) {
+	sets := map[string]Set[int]{
+		"map":        FromArray([]int{3, 1, 2}),
+		"hash":       hashSetOf(3, 1, 2),
+		"concurrent": concurrentOf(3, 1, 2),
+		"sorted":     SortedFromArray([]int{3, 1, 2}, compareInts),
+		"bits":       BitSetOf(3, 1, 2),
+	}
+	for name, s := range sets {
+		data, err := json.Marshal(s)
+		assert.NoError(t, err, name)
+		assert.Equal(t, "[1,2,3]", string(data), name)
+	}
+}
+
+func Test_Set_MarshalJSON_strings(t *testing.T) {
+	data, err := json.Marshal(FromArray([]string{"b", "c", "a"}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, `["a","b","c"]`, string(data))
+}
+
+func Test_Set_MarshalJSON_empty(t *testing.T) {
+	data, err := json.Marshal(New[int]())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "[]", string(data))
+}
+
+func Test_Set_MarshalJSON_unordered(t *testing.T) {
+	s := FromArray([]encodingTestPoint{{1, 2}, {3, 4}})
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+
+	var decoded []encodingTestPoint
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.ElementsMatch(t, []encodingTestPoint{{1, 2}, {3, 4}}, decoded)
+}
+
+func Test_linkedSet_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(LinkedFromArray([]int{3, 1, 2}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "[3,1,2]", string(data), "insertion order")
+}
+
+func Test_Set_UnmarshalJSON(t *testing.T) {
+	sets := map[string]Set[int]{
+		"map":        FromArray([]int{9}),
+		"hash":       hashSetOf(9),
+		"concurrent": concurrentOf(9),
+		"linked":     LinkedFromArray([]int{9}),
+		"sorted":     SortedFromArray([]int{9}, compareInts),
+		"bits":       BitSetOf(9),
+	}
+	for name, s := range sets {
+		assert.NoError(t, json.Unmarshal([]byte("[3, 1, 2, 1]"), &s), name)
+		assert.ElementsMatch(t, []int{1, 2, 3}, elements(s), name)
+		assert.Error(t, json.Unmarshal([]byte(`["a"]`), &s), name)
+	}
+}
+
+func Test_Set_UnmarshalJSON_null(t *testing.T) {
+	s := FromArray([]int{1})
+
+	assert.NoError(t, json.Unmarshal([]byte("null"), s))
+	assert.ElementsMatch(t, []int{1}, elements(s), "unchanged")
+}
+
+func Test_Set_UnmarshalJSON_field(t *testing.T) {
+	type payload struct {
+		Tags Set[string] `json:"tags"`
+	}
+	p := payload{Tags: New[string]()}
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"tags":["go","go","set"]}`), &p))
+	assert.ElementsMatch(t, []string{"go", "set"}, elements(p.Tags))
+}
+
+func Test_sortedSet_UnmarshalJSON(t *testing.T) {
+	s := NewSorted(func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+
+	assert.NoError(t, json.Unmarshal([]byte(`["b","A","a"]`), s))
+	assert.Equal(t, 2, s.Size(), "keeps comparator")
+	assert.True(t, s.Contains("B"), "keeps comparator")
+}
+
+func Test_BitSet_UnmarshalJSON_negative(t *testing.T) {
+	b := BitSetOf(1)
+
+	assert.Error(t, json.Unmarshal([]byte("[2, -1]"), b))
+	assert.Equal(t, []int{1}, drain(b.Iterator()), "unchanged")
+}
+
+func Test_FromJSON(t *testing.T) {
+	r := FromJSON[int]([]byte("[1, 2, 2]"))
+	assert.True(t, r.IsOk(), "ok")
+	assert.ElementsMatch(t, []int{1, 2}, elements(r.Get()))
+
+	assert.True(t, FromJSON[int]([]byte("{}")).IsErr(), "err")
+}
+
+func Test_Persistent_JSON(t *testing.T) {
+	p := PersistentFromArray([]string{"b", "a"})
+	data, err := json.Marshal(p)
+	assert.NoError(t, err)
+	assert.Equal(t, `["a","b"]`, string(data))
+
+	var decoded struct {
+		Set Persistent[string]
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"Set":["x","y"]}`), &decoded))
+	assert.Equal(t, 2, decoded.Set.Size())
+	assert.True(t, decoded.Set.Contains("x"))
+	assert.True(t, decoded.Set.With("z").Contains("z"), "usable")
+}
+
+func Test_Set_MarshalBinary(t *testing.T) {
+	sets := map[string]func() Set[int]{
+		"map":        func() Set[int] { return New[int]() },
+		"hash":       func() Set[int] { return hashSetOf() },
+		"concurrent": func() Set[int] { return concurrentOf() },
+		"linked":     func() Set[int] { return NewLinked[int]() },
+		"sorted":     func() Set[int] { return NewSorted(compareInts) },
+		"bits":       func() Set[int] { return NewBitSet() },
+	}
+	for name, create := range sets {
+		s := create()
+		s.AddAll(FromArray([]int{5, 1, 64, 65, 66, 300}))
+		data, err := s.(interface{ MarshalBinary() ([]byte, error) }).MarshalBinary()
+		assert.NoError(t, err, name)
+
+		decoded := create()
+		decoded.Add(7)
+		err = decoded.(interface{ UnmarshalBinary([]byte) error }).UnmarshalBinary(data)
+		assert.NoError(t, err, name)
+		assert.True(t, decoded.Equal(s), name)
+	}
+}
+
+func Test_Set_gob(t *testing.T) {
+	s := FromArray([]string{"a", "b"})
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(s))
+
+	decoded := New[string]()
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(decoded))
+	assert.True(t, decoded.Equal(s))
+}
+
+func Test_Set_gob_field(t *testing.T) {
+	type payload struct {
+		Bits *BitSet
+		Set  *Persistent[int]
+	}
+	in := payload{Bits: BitSetOf(1, 2, 1000), Set: PersistentFromArray([]int{4, 5})}
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(in))
+
+	var out payload
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&out))
+	assert.Equal(t, []int{1, 2, 1000}, drain(out.Bits.Iterator()))
+	assert.Equal(t, 2, out.Set.Size())
+	assert.True(t, out.Set.Contains(5))
+}
+
+func Test_BitSet_MarshalBinary(t *testing.T) {
+	b := NewCompressedBitSet()
+	for v := 1000000; v < 2000000; v++ {
+		b.Add(v)
+	}
+	data, err := b.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Less(t, len(data), 16, "compact")
+
+	decoded := NewBitSet()
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.True(t, decoded.Compressed(), "mode")
+	assert.Equal(t, 1000000, decoded.Size())
+	assert.True(t, decoded.Equal(b))
+}
+
+func Test_BitSet_UnmarshalBinary_invalid(t *testing.T) {
+	data, _ := BitSetOf(1, 2, 10).MarshalBinary()
+	b := NewBitSet()
+
+	assert.Error(t, b.UnmarshalBinary(nil), "empty")
+	assert.Error(t, b.UnmarshalBinary(data[:len(data)-1]), "truncated")
+	assert.Error(t, b.UnmarshalBinary(append(data, 0)), "trailing")
+	data[0] = 9
+	assert.Error(t, b.UnmarshalBinary(data), "version")
+}
+
+func Test_BitSet_UnmarshalBinary_range(t *testing.T) {
+	encode := func(values ...uint64) []byte {
+		data := []byte{bitSetVersion, 0}
+		for _, v := range values {
+			buf := make([]byte, binary.MaxVarintLen64)
+			data = append(data, buf[:binary.PutUvarint(buf, v)]...)
+		}
+		return data
+	}
+	b := BitSetOf(1)
+
+	assert.Error(t, b.UnmarshalBinary(encode(1, math.MaxInt32, math.MaxInt32)), "one run")
+	assert.Error(t, b.UnmarshalBinary(encode(2, 0, math.MaxInt32, 1, 1)), "two runs")
+	assert.Equal(t, []int{1}, b.ToSlice(), "unchanged")
+}
+
+func hashSetOf(values ...int) Set[int] {
+	s := WithHasherAndEquals(len(values), func(v int) int {
+		return v % 2
+	}, func(a, b int) bool {
+		return a == b
+	})
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+func concurrentOf(values ...int) Set[int] {
+	s := ConcurrentWithShards[int](4)
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
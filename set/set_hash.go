@@ -0,0 +1,230 @@
+package set
+
+import (
+	"encoding/json"
+
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/stream"
+)
+
+// hashSet stores its elements in buckets indexed by the element hash, chaining
+// the elements with colliding hashes and telling them apart with the
+// `equals` function.
+type hashSet[K comparable, V any] struct {
+	buckets map[K][]V
+	hasher  func(V) K
+	equals  func(V, V) bool
+	size    int
+}
+
+func (s *hashSet[K, V]) indexOf(bucket []V, element V) int {
+	for i, el := range bucket {
+		if s.equals(el, element) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *hashSet[K, V]) Contains(element V) bool {
+	return s.indexOf(s.buckets[s.hasher(element)], element) >= 0
+}
+
+func (s *hashSet[K, V]) ContainsAll(iter iterator.Iterable[V]) bool {
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		if !s.Contains(o.Get()) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *hashSet[K, V]) Add(element V) bool {
+	hash := s.hasher(element)
+	bucket := s.buckets[hash]
+	if i := s.indexOf(bucket, element); i >= 0 {
+		bucket[i] = element
+		return false
+	}
+	s.buckets[hash] = append(bucket, element)
+	s.size++
+	return true
+}
+
+func (s *hashSet[K, V]) AddAll(iter iterator.Iterable[V]) {
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		s.Add(o.Get())
+	}
+}
+
+func (s *hashSet[K, V]) Iterator() iterator.Iterator[V] {
+	items := make([]V, 0, s.size)
+	for _, bucket := range s.buckets {
+		items = append(items, bucket...)
+	}
+	return iterator.FromArray(items)
+}
+
+func (s *hashSet[K, V]) ForEach(fn func(V)) {
+	for _, bucket := range s.buckets {
+		for _, el := range bucket {
+			fn(el)
+		}
+	}
+}
+
+func (s *hashSet[K, V]) Remove(element V) bool {
+	hash := s.hasher(element)
+	bucket := s.buckets[hash]
+	i := s.indexOf(bucket, element)
+	if i < 0 {
+		return false
+	}
+	last := len(bucket) - 1
+	if last == 0 {
+		delete(s.buckets, hash)
+	} else {
+		var zero V
+		bucket[i] = bucket[last]
+		bucket[last] = zero
+		s.buckets[hash] = bucket[:last]
+	}
+	s.size--
+	return true
+}
+
+func (s *hashSet[K, V]) Empty() bool {
+	return s.size == 0
+}
+
+func (s *hashSet[K, V]) Size() int {
+	return s.size
+}
+
+func (s *hashSet[K, V]) Clear() {
+	s.buckets = make(map[K][]V)
+	s.size = 0
+}
+
+func (s *hashSet[K, V]) Clone() Set[V] {
+	buckets := make(map[K][]V, len(s.buckets))
+	for hash, bucket := range s.buckets {
+		buckets[hash] = append([]V(nil), bucket...)
+	}
+	return &hashSet[K, V]{
+		buckets: buckets,
+		hasher:  s.hasher,
+		equals:  s.equals,
+		size:    s.size,
+	}
+}
+
+func (s *hashSet[K, V]) Filter(condition func(V) bool) Set[V] {
+	return filter[V](s, condition, s.empty(0))
+}
+
+func (s *hashSet[K, V]) ToSlice() []V {
+	return toSlice[V](s)
+}
+
+func (s *hashSet[K, V]) Stream() stream.Stream[V] {
+	return stream.FromIterable[V](s)
+}
+
+func (s *hashSet[K, V]) String() string {
+	return toString(sortedSlice[V](s))
+}
+
+func (s *hashSet[K, V]) empty(size int) Set[V] {
+	return WithHasherAndEquals(size, s.hasher, s.equals)
+}
+
+func (s *hashSet[K, V]) Union(other Set[V]) Set[V] {
+	return union[V](s, other, s.empty(s.Size()+other.Size()))
+}
+
+func (s *hashSet[K, V]) Intersection(other Set[V]) Set[V] {
+	return intersection[V](s, other, s.empty(s.Size()))
+}
+
+func (s *hashSet[K, V]) Difference(other Set[V]) Set[V] {
+	return difference[V](s, other, s.empty(s.Size()))
+}
+
+func (s *hashSet[K, V]) SymmetricDifference(other Set[V]) Set[V] {
+	return symmetricDifference[V](s, other, s.empty(s.Size()+other.Size()))
+}
+
+func (s *hashSet[K, V]) IsSubsetOf(other Set[V]) bool {
+	return isSubset[V](s, other)
+}
+
+func (s *hashSet[K, V]) IsSupersetOf(other Set[V]) bool {
+	return isSubset[V](other, s)
+}
+
+func (s *hashSet[K, V]) IsDisjoint(other Set[V]) bool {
+	return isDisjoint[V](s, other)
+}
+
+func (s *hashSet[K, V]) Equal(other Set[V]) bool {
+	return equal[V](s, other)
+}
+
+func (s *hashSet[K, V]) RetainAll(other Set[V]) {
+	retainAll[V](s, other)
+}
+
+func (s *hashSet[K, V]) RemoveAll(iter iterator.Iterable[V]) {
+	removeAll[V](s, iter)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s *hashSet[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(sortedSlice[V](s))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *hashSet[K, V]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+	elements, err := decodeJSON[V](data)
+	if err != nil {
+		return err
+	}
+	replace[V](s, elements)
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (s *hashSet[K, V]) MarshalBinary() ([]byte, error) {
+	return encodeBinary(sortedSlice[V](s))
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (s *hashSet[K, V]) UnmarshalBinary(data []byte) error {
+	elements, err := decodeBinary[V](data)
+	if err != nil {
+		return err
+	}
+	replace[V](s, elements)
+	return nil
+}
+
+// WithHasherAndEquals creates a hash set for elements of any type, including
+// non comparable ones.
+//
+// Elements are indexed by the key computed by the `hasher` function. Distinct
+// elements may share the same key: colliding elements are kept apart using
+// the `equals` function, which must return true for equal elements. Equal
+// elements must produce the same key.
+func WithHasherAndEquals[K comparable, V any](size int, hasher func(V) K, equals func(V, V) bool) Set[V] {
+	return &hashSet[K, V]{
+		buckets: make(map[K][]V, size),
+		hasher:  hasher,
+		equals:  equals,
+	}
+}
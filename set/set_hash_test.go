@@ -0,0 +1,143 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+// collidingSet stores integers in only 3 buckets.
+func collidingSet(values ...int) Set[int] {
+	s := WithHasherAndEquals(len(values), func(v int) int {
+		return v % 3
+	}, func(a, b int) bool {
+		return a == b
+	})
+	s.AddAll(iterator.ArrayIterable(values))
+	return s
+}
+
+func sliceSet() Set[[]int] {
+	return WithHasherAndEquals(0, func(v []int) int {
+		sum := 0
+		for _, i := range v {
+			sum += i
+		}
+		return sum
+	}, func(a, b []int) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func Test_HashSet_Contains(t *testing.T) {
+	s := collidingSet(1, 4, 7, 10, 2)
+
+	for _, val := range []int{1, 4, 7, 10, 2} {
+		assert.True(t, s.Contains(val), "contains %d", val)
+	}
+	assert.False(t, s.Contains(13), "colliding")
+	assert.False(t, s.Contains(3), "empty bucket")
+	assert.Equal(t, 5, s.Size(), "size")
+}
+
+func Test_HashSet_Add_duplicate(t *testing.T) {
+	s := collidingSet(1, 4)
+	s.Add(4)
+	s.Add(1)
+
+	assert.Equal(t, 2, s.Size(), "size")
+}
+
+func Test_HashSet_Remove(t *testing.T) {
+	s := collidingSet(1, 4, 7, 10)
+
+	s.Remove(4)
+	assert.False(t, s.Contains(4), "removed")
+	assert.True(t, s.Contains(1), "kept")
+	assert.True(t, s.Contains(7), "kept")
+	assert.True(t, s.Contains(10), "kept")
+	assert.Equal(t, 3, s.Size(), "size")
+
+	s.Remove(13)
+	assert.Equal(t, 3, s.Size(), "absent")
+
+	s.Remove(1)
+	s.Remove(7)
+	s.Remove(10)
+	assert.True(t, s.Empty(), "empty")
+	assert.Empty(t, s.(*hashSet[int, int]).buckets, "buckets")
+}
+
+func Test_HashSet_Iterator(t *testing.T) {
+	data := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	s := collidingSet(data...)
+	it := s.Iterator()
+
+	var actual []int
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		actual = append(actual, o.Get())
+	}
+
+	assert.ElementsMatch(t, data, actual)
+	assert.Equal(t, fluent.Present(len(data)), iterator.Size(s.Iterator()), "size")
+}
+
+func Test_HashSet_ForEach(t *testing.T) {
+	data := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	assert.ElementsMatch(t, data, elements(collidingSet(data...)))
+}
+
+func Test_HashSet_ContainsAll(t *testing.T) {
+	s := collidingSet(1, 4, 7)
+
+	assert.True(t, s.ContainsAll(iterator.ArrayIterable([]int{1, 7})))
+	assert.False(t, s.ContainsAll(iterator.ArrayIterable([]int{1, 10})))
+}
+
+func Test_HashSet_nonComparable(t *testing.T) {
+	s := sliceSet()
+	s.Add([]int{1, 2})
+	s.Add([]int{2, 1})
+	s.Add([]int{3})
+	s.Add([]int{1, 2})
+
+	assert.Equal(t, 3, s.Size(), "size")
+	assert.True(t, s.Contains([]int{2, 1}), "contains")
+	assert.False(t, s.Contains([]int{1, 1, 1}), "colliding")
+
+	s.Remove([]int{1, 2})
+	assert.False(t, s.Contains([]int{1, 2}), "removed")
+	assert.True(t, s.Contains([]int{2, 1}), "kept")
+	assert.True(t, s.Contains([]int{3}), "kept")
+}
+
+func Test_HashSet_algebra(t *testing.T) {
+	a := collidingSet(1, 2, 3, 4, 5, 6)
+	b := collidingSet(4, 5, 6, 7, 8, 9)
+
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, elements(a.Union(b)), "union")
+	assert.ElementsMatch(t, []int{4, 5, 6}, elements(a.Intersection(b)), "intersection")
+	assert.ElementsMatch(t, []int{1, 2, 3}, elements(a.Difference(b)), "difference")
+	assert.ElementsMatch(t, []int{1, 2, 3, 7, 8, 9}, elements(a.SymmetricDifference(b)), "symmetric")
+	assert.True(t, collidingSet(1, 4).IsSubsetOf(a), "subset")
+	assert.True(t, a.IsSupersetOf(FromArray([]int{1, 4})), "superset")
+	assert.False(t, a.IsDisjoint(b), "disjoint")
+	assert.True(t, a.Equal(FromArray([]int{6, 5, 4, 3, 2, 1})), "equal")
+
+	a.RetainAll(b)
+	assert.ElementsMatch(t, []int{4, 5, 6}, elements(a), "retain")
+
+	a.RemoveAll(iterator.ArrayIterable([]int{4, 7}))
+	assert.ElementsMatch(t, []int{5, 6}, elements(a), "remove")
+}
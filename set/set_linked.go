@@ -0,0 +1,226 @@
+package set
+
+import (
+	"encoding/json"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/internal/linked"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/stream"
+)
+
+// LinkedSet is a Set which iterates over its elements in the order they were
+// first added. Elements removed while iterating are never returned by the
+// iteration; elements added while iterating may or may not be returned.
+type LinkedSet[T any] interface {
+	Set[T]
+
+	// First returns the oldest element of the set, if any.
+	First() fluent.Option[T]
+	// Last returns the newest element of the set, if any.
+	Last() fluent.Option[T]
+}
+
+type linkedSet[T comparable] struct {
+	items *linked.Map[T, struct{}]
+}
+
+func (s *linkedSet[T]) Contains(element T) bool {
+	return s.items.Find(element) != nil
+}
+
+func (s *linkedSet[T]) ContainsAll(iter iterator.Iterable[T]) bool {
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		if s.items.Find(o.Get()) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *linkedSet[T]) Add(element T) bool {
+	if s.items.Find(element) != nil {
+		return false
+	}
+	s.items.Put(element, struct{}{})
+	return true
+}
+
+func (s *linkedSet[T]) AddAll(iter iterator.Iterable[T]) {
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		s.Add(o.Get())
+	}
+}
+
+func (s *linkedSet[T]) Remove(element T) bool {
+	return s.items.Remove(element).IsPresent()
+}
+
+func (s *linkedSet[T]) Clear() {
+	s.items.Clear()
+}
+
+func (s *linkedSet[T]) Clone() Set[T] {
+	clone := LinkedWithSize[T](s.items.Len())
+	clone.AddAll(s)
+	return clone
+}
+
+func (s *linkedSet[T]) Filter(condition func(T) bool) Set[T] {
+	return filter[T](s, condition, NewLinked[T]())
+}
+
+func (s *linkedSet[T]) ToSlice() []T {
+	return toSlice[T](s)
+}
+
+func (s *linkedSet[T]) Stream() stream.Stream[T] {
+	return stream.FromIterable[T](s)
+}
+
+func (s *linkedSet[T]) String() string {
+	return toString(toSlice[T](s))
+}
+
+func (s *linkedSet[T]) Iterator() iterator.Iterator[T] {
+	return s.items.Keys()
+}
+
+func (s *linkedSet[T]) ForEach(fn func(T)) {
+	s.items.ForEach(func(el T, _ struct{}) {
+		fn(el)
+	})
+}
+
+func (s *linkedSet[T]) Empty() bool {
+	return s.items.Len() == 0
+}
+
+func (s *linkedSet[T]) Size() int {
+	return s.items.Len()
+}
+
+func (s *linkedSet[T]) First() fluent.Option[T] {
+	if node := s.items.First(); node != nil {
+		return fluent.Present(node.Key)
+	}
+	return fluent.Empty[T]()
+}
+
+func (s *linkedSet[T]) Last() fluent.Option[T] {
+	if node := s.items.Last(); node != nil {
+		return fluent.Present(node.Key)
+	}
+	return fluent.Empty[T]()
+}
+
+func (s *linkedSet[T]) Union(other Set[T]) Set[T] {
+	return union[T](s, other, LinkedWithSize[T](s.Size()+other.Size()))
+}
+
+func (s *linkedSet[T]) Intersection(other Set[T]) Set[T] {
+	result := LinkedWithSize[T](s.Size())
+	s.ForEach(func(el T) {
+		if other.Contains(el) {
+			result.Add(el)
+		}
+	})
+	return result
+}
+
+func (s *linkedSet[T]) Difference(other Set[T]) Set[T] {
+	result := LinkedWithSize[T](s.Size())
+	s.ForEach(func(el T) {
+		if !other.Contains(el) {
+			result.Add(el)
+		}
+	})
+	return result
+}
+
+func (s *linkedSet[T]) SymmetricDifference(other Set[T]) Set[T] {
+	return symmetricDifference[T](s, other, LinkedWithSize[T](s.Size()+other.Size()))
+}
+
+func (s *linkedSet[T]) IsSubsetOf(other Set[T]) bool {
+	return isSubset[T](s, other)
+}
+
+func (s *linkedSet[T]) IsSupersetOf(other Set[T]) bool {
+	return isSubset[T](other, s)
+}
+
+func (s *linkedSet[T]) IsDisjoint(other Set[T]) bool {
+	return isDisjoint[T](s, other)
+}
+
+func (s *linkedSet[T]) Equal(other Set[T]) bool {
+	return equal[T](s, other)
+}
+
+func (s *linkedSet[T]) RetainAll(other Set[T]) {
+	for node := s.items.First(); node != nil; node = node.Next() {
+		if !other.Contains(node.Key) {
+			s.items.Remove(node.Key)
+		}
+	}
+}
+
+func (s *linkedSet[T]) RemoveAll(iter iterator.Iterable[T]) {
+	removeAll[T](s, iter)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s *linkedSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(toSlice[T](s))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *linkedSet[T]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+	elements, err := decodeJSON[T](data)
+	if err != nil {
+		return err
+	}
+	replace[T](s, elements)
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (s *linkedSet[T]) MarshalBinary() ([]byte, error) {
+	return encodeBinary(toSlice[T](s))
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (s *linkedSet[T]) UnmarshalBinary(data []byte) error {
+	elements, err := decodeBinary[T](data)
+	if err != nil {
+		return err
+	}
+	replace[T](s, elements)
+	return nil
+}
+
+// NewLinked creates an empty LinkedSet.
+func NewLinked[T comparable]() LinkedSet[T] {
+	return LinkedWithSize[T](16)
+}
+
+// LinkedWithSize creates an empty LinkedSet with room for `size` elements.
+func LinkedWithSize[T comparable](size int) LinkedSet[T] {
+	return &linkedSet[T]{
+		items: linked.New[T, struct{}](size),
+	}
+}
+
+// LinkedFromArray creates a LinkedSet with the elements of the array, in the
+// order they appear in the array.
+func LinkedFromArray[T comparable](arr []T) LinkedSet[T] {
+	set := LinkedWithSize[T](len(arr))
+	set.AddAll(iterator.ArrayIterable(arr))
+	return set
+}
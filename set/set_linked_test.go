@@ -0,0 +1,132 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LinkedSet_order(t *testing.T) {
+	data := []int{5, 3, 9, 1, 7, 3, 5}
+	s := LinkedFromArray(data)
+
+	assert.Equal(t, []int{5, 3, 9, 1, 7}, elements[int](s), "ForEach")
+	assert.Equal(t, []int{5, 3, 9, 1, 7}, stream.FromIterable[int](s).Array(), "Iterator")
+	assert.Equal(t, 5, s.Size(), "size")
+}
+
+func Test_LinkedSet_Remove(t *testing.T) {
+	s := LinkedFromArray([]int{1, 2, 3, 4})
+
+	s.Remove(1)
+	s.Remove(3)
+	s.Remove(4)
+	s.Remove(8)
+	assert.Equal(t, []int{2}, elements[int](s))
+	assert.Equal(t, fluent.Present(2), s.First(), "first")
+	assert.Equal(t, fluent.Present(2), s.Last(), "last")
+
+	s.Add(1)
+	assert.Equal(t, []int{2, 1}, elements[int](s), "re-added")
+
+	s.Remove(2)
+	s.Remove(1)
+	assert.True(t, s.Empty(), "empty")
+	assert.False(t, s.First().IsPresent(), "first")
+	assert.False(t, s.Last().IsPresent(), "last")
+}
+
+func Test_LinkedSet_Remove_whileIterating(t *testing.T) {
+	s := LinkedFromArray([]int{1, 2, 3, 4})
+	it := s.Iterator()
+
+	var actual []int
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		actual = append(actual, o.Get())
+		s.Remove(o.Get())
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4}, actual)
+	assert.True(t, s.Empty(), "empty")
+}
+
+func Test_LinkedSet_Remove_next(t *testing.T) {
+	s := LinkedFromArray([]int{1, 2, 3, 4})
+	it := s.Iterator()
+
+	assert.Equal(t, 1, it.Next().Get())
+	s.Remove(2)
+	s.Remove(3)
+	assert.Equal(t, 4, it.Next().Get(), "removed elements skipped")
+	assert.False(t, it.Next().IsPresent())
+}
+
+func Test_LinkedSet_FirstLast(t *testing.T) {
+	s := LinkedFromArray([]int{8, 2, 5})
+
+	assert.Equal(t, fluent.Present(8), s.First())
+	assert.Equal(t, fluent.Present(5), s.Last())
+}
+
+func Test_LinkedSet_Contains(t *testing.T) {
+	s := LinkedFromArray([]int{8, 2, 5})
+
+	assert.True(t, s.Contains(2))
+	assert.False(t, s.Contains(3))
+	assert.True(t, s.ContainsAll(iterator.ArrayIterable([]int{8, 5})))
+	assert.False(t, s.ContainsAll(iterator.ArrayIterable([]int{8, 6})))
+}
+
+// The number of elements returned changes as elements are added or removed
+// while iterating, so the iterator does not report a size.
+func Test_linkedIterator_Size(t *testing.T) {
+	s := NewLinked[int]()
+	s.Add(1)
+
+	assert.False(t, iterator.Size(s.Iterator()).IsPresent())
+}
+
+func Test_LinkedSet_Stream_remove(t *testing.T) {
+	s := LinkedFromArray([]int{1, 2, 3, 4})
+	out := s.Stream().Peek(func(el int) {
+		if el == 2 {
+			s.Remove(3)
+		}
+	}).Array()
+
+	assert.Equal(t, []int{1, 2, 4}, out)
+}
+
+func Test_LinkedSet_Stream_add(t *testing.T) {
+	s := LinkedFromArray([]int{1, 2, 3, 4})
+	out := s.Stream().Peek(func(el int) {
+		if el == 2 {
+			s.Add(5)
+		}
+	}).Array()
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, out)
+}
+
+func Test_LinkedSet_algebra(t *testing.T) {
+	a := LinkedFromArray([]int{4, 3, 2, 1})
+	b := LinkedFromArray([]int{6, 5, 3, 4})
+
+	assert.Equal(t, []int{4, 3, 2, 1, 6, 5}, elements(a.Union(b)), "union")
+	assert.Equal(t, []int{4, 3}, elements(a.Intersection(b)), "intersection")
+	assert.Equal(t, []int{2, 1}, elements(a.Difference(b)), "difference")
+	assert.Equal(t, []int{2, 1, 6, 5}, elements(a.SymmetricDifference(b)), "symmetric")
+	assert.True(t, a.IsSubsetOf(FromArray([]int{1, 2, 3, 4, 5})), "subset")
+	assert.True(t, a.IsSupersetOf(FromArray([]int{1, 2})), "superset")
+	assert.True(t, a.IsDisjoint(FromArray([]int{7, 8})), "disjoint")
+	assert.True(t, a.Equal(FromArray([]int{1, 2, 3, 4})), "equal")
+
+	a.RetainAll(b)
+	assert.Equal(t, []int{4, 3}, elements[int](a), "retain")
+
+	a.RemoveAll(iterator.ArrayIterable([]int{4}))
+	assert.Equal(t, []int{3}, elements[int](a), "remove")
+}
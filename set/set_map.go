@@ -1,7 +1,10 @@
 package set
 
 import (
+	"encoding/json"
+
 	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/stream"
 )
 
 type mapSet[K comparable, V any] struct {
@@ -25,8 +28,13 @@ func (s mapSet[K, V]) ContainsAll(iter iterator.Iterable[V]) bool {
 	return true
 }
 
-func (s mapSet[K, V]) Add(element V) {
-	s.items[s.hasher(element)] = element
+// Add returns true if no element with the same key was present. An element
+// with the same key is replaced either way.
+func (s mapSet[K, V]) Add(element V) bool {
+	key := s.hasher(element)
+	_, found := s.items[key]
+	s.items[key] = element
+	return !found
 }
 
 func (s mapSet[K, V]) AddAll(iter iterator.Iterable[V]) {
@@ -48,8 +56,46 @@ func (s mapSet[K, V]) ForEach(fn func(V)) {
 	}
 }
 
-func (s mapSet[K, V]) Remove(el V) {
-	delete(s.items, s.hasher(el))
+func (s mapSet[K, V]) Remove(el V) bool {
+	key := s.hasher(el)
+	if _, found := s.items[key]; !found {
+		return false
+	}
+	delete(s.items, key)
+	return true
+}
+
+func (s mapSet[K, V]) Clear() {
+	for k := range s.items {
+		delete(s.items, k)
+	}
+}
+
+func (s mapSet[K, V]) Clone() Set[V] {
+	items := make(map[K]V, len(s.items))
+	for k, v := range s.items {
+		items[k] = v
+	}
+	return &mapSet[K, V]{
+		items:  items,
+		hasher: s.hasher,
+	}
+}
+
+func (s mapSet[K, V]) Filter(condition func(V) bool) Set[V] {
+	return filter[V](s, condition, s.empty(0))
+}
+
+func (s mapSet[K, V]) ToSlice() []V {
+	return toSlice[V](s)
+}
+
+func (s mapSet[K, V]) Stream() stream.Stream[V] {
+	return stream.FromIterable[V](s)
+}
+
+func (s mapSet[K, V]) String() string {
+	return toString(sortedSlice[V](s))
 }
 
 func (s mapSet[K, V]) Empty() bool {
@@ -60,6 +106,94 @@ func (s mapSet[K, V]) Size() int {
 	return len(s.items)
 }
 
+func (s mapSet[K, V]) empty(size int) Set[V] {
+	return &mapSet[K, V]{
+		items:  make(map[K]V, size),
+		hasher: s.hasher,
+	}
+}
+
+func (s mapSet[K, V]) Union(other Set[V]) Set[V] {
+	return union[V](s, other, s.empty(s.Size()+other.Size()))
+}
+
+func (s mapSet[K, V]) Intersection(other Set[V]) Set[V] {
+	size := s.Size()
+	if other.Size() < size {
+		size = other.Size()
+	}
+	return intersection[V](s, other, s.empty(size))
+}
+
+func (s mapSet[K, V]) Difference(other Set[V]) Set[V] {
+	return difference[V](s, other, s.empty(s.Size()))
+}
+
+func (s mapSet[K, V]) SymmetricDifference(other Set[V]) Set[V] {
+	return symmetricDifference[V](s, other, s.empty(s.Size()+other.Size()))
+}
+
+func (s mapSet[K, V]) IsSubsetOf(other Set[V]) bool {
+	return isSubset[V](s, other)
+}
+
+func (s mapSet[K, V]) IsSupersetOf(other Set[V]) bool {
+	return isSubset(other, Set[V](s))
+}
+
+func (s mapSet[K, V]) IsDisjoint(other Set[V]) bool {
+	return isDisjoint[V](s, other)
+}
+
+func (s mapSet[K, V]) Equal(other Set[V]) bool {
+	return equal[V](s, other)
+}
+
+func (s mapSet[K, V]) RetainAll(other Set[V]) {
+	for k, v := range s.items {
+		if !other.Contains(v) {
+			delete(s.items, k)
+		}
+	}
+}
+
+func (s mapSet[K, V]) RemoveAll(iter iterator.Iterable[V]) {
+	removeAll[V](s, iter)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s mapSet[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(sortedSlice[V](s))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *mapSet[K, V]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+	elements, err := decodeJSON[V](data)
+	if err != nil {
+		return err
+	}
+	replace[V](s, elements)
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (s mapSet[K, V]) MarshalBinary() ([]byte, error) {
+	return encodeBinary(sortedSlice[V](s))
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (s *mapSet[K, V]) UnmarshalBinary(data []byte) error {
+	elements, err := decodeBinary[V](data)
+	if err != nil {
+		return err
+	}
+	replace[V](s, elements)
+	return nil
+}
+
 func New[T comparable]() Set[T] {
 	return WithSize[T](16)
 }
@@ -70,6 +204,12 @@ func WithSize[T comparable](size int) Set[T] {
 	})
 }
 
+// WithSizeAndHasher creates a set where each element is identified by the key
+// computed by the `hasher` function.
+//
+// Elements producing the same key are considered equal: adding an element
+// replaces the element with the same key. To store distinct elements which
+// may produce colliding keys, use WithHasherAndEquals.
 func WithSizeAndHasher[K comparable, V any](size int, hasher func(V) K) Set[V] {
 	return &mapSet[K, V]{
 		items:  make(map[K]V, size),
@@ -83,8 +223,23 @@ func FromArray[T comparable](arr []T) Set[T] {
 	return set
 }
 
+// FromIterable creates a set with the elements of the iterable, sized for the
+// number of elements of its iterator, if known.
 func FromIterable[T comparable](iter iterator.Iterable[T]) Set[T] {
-	s := New[T]()
-	s.AddAll(iter)
+	it := iter.Iterator()
+	s := WithSize[T](iterator.Size(it).OrElse(16))
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		s.Add(o.Get())
+	}
 	return s
 }
+
+// Map creates a set with the results of applying the `mapper` function to the
+// elements of the set `s`.
+func Map[T any, R comparable](s Set[T], mapper func(T) R) Set[R] {
+	result := WithSize[R](s.Size())
+	s.ForEach(func(el T) {
+		result.Add(mapper(el))
+	})
+	return result
+}
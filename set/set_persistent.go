@@ -0,0 +1,455 @@
+package set
+
+import (
+	"encoding/json"
+	"math/bits"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/internal/hash"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/stream"
+)
+
+// Persistent is an immutable set. Adding or removing elements returns a new
+// version of the set, sharing with the previous version every part of its
+// structure which was not modified.
+//
+// Persistent is backed by a hash array mapped trie (HAMT), so adding,
+// removing and looking up elements take a time proportional to the logarithm
+// of the set size. Every version is safe for use by multiple goroutines.
+type Persistent[T comparable] struct {
+	root   *hamtNode[T]
+	size   int
+	hasher func(T) uint64
+}
+
+const (
+	hamtBits  = 5
+	hamtWidth = 1 << hamtBits
+	hamtMask  = hamtWidth - 1
+	hashBits  = 64
+)
+
+// hamtNode is either a branch, holding up to 32 entries indexed by 5 bits of
+// the element hash, or, once every bit of the hash was used, a collision node
+// holding the elements sharing the same hash.
+type hamtNode[T comparable] struct {
+	bitmap     uint32
+	entries    []hamtEntry[T]
+	hash       uint64
+	collisions []T
+}
+
+// hamtEntry is either a leaf holding a single element or a link to a child
+// node.
+type hamtEntry[T comparable] struct {
+	hash  uint64
+	value T
+	child *hamtNode[T]
+}
+
+func (n *hamtNode[T]) collision() bool {
+	return n.collisions != nil
+}
+
+func (n *hamtNode[T]) position(hash uint64, shift uint) (uint32, int) {
+	bit := uint32(1) << ((hash >> shift) & hamtMask)
+	return bit, bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *hamtNode[T]) contains(hash uint64, shift uint, element T) bool {
+	for {
+		if n.collision() {
+			for _, el := range n.collisions {
+				if el == element {
+					return true
+				}
+			}
+			return false
+		}
+		bit, pos := n.position(hash, shift)
+		if n.bitmap&bit == 0 {
+			return false
+		}
+		entry := n.entries[pos]
+		if entry.child == nil {
+			return entry.value == element
+		}
+		n = entry.child
+		shift += hamtBits
+	}
+}
+
+// replace returns a copy of the branch with the entry at `pos` replaced.
+func (n *hamtNode[T]) replace(pos int, entry hamtEntry[T]) *hamtNode[T] {
+	entries := make([]hamtEntry[T], len(n.entries))
+	copy(entries, n.entries)
+	entries[pos] = entry
+	return &hamtNode[T]{
+		bitmap:  n.bitmap,
+		entries: entries,
+	}
+}
+
+func newHamtBranch[T comparable](shift uint, a, b hamtEntry[T]) *hamtNode[T] {
+	if shift >= hashBits {
+		return &hamtNode[T]{
+			hash:       a.hash,
+			collisions: []T{a.value, b.value},
+		}
+	}
+	ia := (a.hash >> shift) & hamtMask
+	ib := (b.hash >> shift) & hamtMask
+	if ia == ib {
+		return &hamtNode[T]{
+			bitmap: 1 << ia,
+			entries: []hamtEntry[T]{{
+				child: newHamtBranch(shift+hamtBits, a, b),
+			}},
+		}
+	}
+	if ib < ia {
+		a, b = b, a
+	}
+	return &hamtNode[T]{
+		bitmap:  1<<ia | 1<<ib,
+		entries: []hamtEntry[T]{a, b},
+	}
+}
+
+func (n *hamtNode[T]) with(hash uint64, shift uint, element T) (*hamtNode[T], bool) {
+	if n.collision() {
+		if n.contains(hash, shift, element) {
+			return n, false
+		}
+		collisions := make([]T, len(n.collisions), len(n.collisions)+1)
+		copy(collisions, n.collisions)
+		return &hamtNode[T]{
+			hash:       n.hash,
+			collisions: append(collisions, element),
+		}, true
+	}
+
+	leaf := hamtEntry[T]{hash: hash, value: element}
+	bit, pos := n.position(hash, shift)
+	if n.bitmap&bit == 0 {
+		entries := make([]hamtEntry[T], len(n.entries)+1)
+		copy(entries, n.entries[:pos])
+		entries[pos] = leaf
+		copy(entries[pos+1:], n.entries[pos:])
+		return &hamtNode[T]{
+			bitmap:  n.bitmap | bit,
+			entries: entries,
+		}, true
+	}
+
+	entry := n.entries[pos]
+	if entry.child != nil {
+		child, added := entry.child.with(hash, shift+hamtBits, element)
+		if !added {
+			return n, false
+		}
+		return n.replace(pos, hamtEntry[T]{child: child}), true
+	}
+	if entry.value == element {
+		return n, false
+	}
+	return n.replace(pos, hamtEntry[T]{
+		child: newHamtBranch(shift+hamtBits, entry, leaf),
+	}), true
+}
+
+// single returns the only element of a node, if the node holds a single
+// element and can be inlined into its parent.
+func (n *hamtNode[T]) single() (hamtEntry[T], bool) {
+	if n.collision() {
+		if len(n.collisions) == 1 {
+			return hamtEntry[T]{hash: n.hash, value: n.collisions[0]}, true
+		}
+	} else if len(n.entries) == 1 && n.entries[0].child == nil {
+		return n.entries[0], true
+	}
+	return hamtEntry[T]{}, false
+}
+
+// without returns the node without the element, or nil if the node would be
+// left empty.
+func (n *hamtNode[T]) without(hash uint64, shift uint, element T) (*hamtNode[T], bool) {
+	if n.collision() {
+		for i, el := range n.collisions {
+			if el == element {
+				if len(n.collisions) == 1 {
+					return nil, true
+				}
+				collisions := make([]T, 0, len(n.collisions)-1)
+				collisions = append(collisions, n.collisions[:i]...)
+				collisions = append(collisions, n.collisions[i+1:]...)
+				return &hamtNode[T]{
+					hash:       n.hash,
+					collisions: collisions,
+				}, true
+			}
+		}
+		return n, false
+	}
+
+	bit, pos := n.position(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	entry := n.entries[pos]
+	if entry.child != nil {
+		child, removed := entry.child.without(hash, shift+hamtBits, element)
+		if !removed {
+			return n, false
+		}
+		if child != nil {
+			if leaf, ok := child.single(); ok {
+				return n.replace(pos, leaf), true
+			}
+			return n.replace(pos, hamtEntry[T]{child: child}), true
+		}
+	} else if entry.value != element {
+		return n, false
+	}
+
+	if len(n.entries) == 1 {
+		return nil, true
+	}
+	entries := make([]hamtEntry[T], 0, len(n.entries)-1)
+	entries = append(entries, n.entries[:pos]...)
+	entries = append(entries, n.entries[pos+1:]...)
+	return &hamtNode[T]{
+		bitmap:  n.bitmap &^ bit,
+		entries: entries,
+	}, true
+}
+
+func (n *hamtNode[T]) forEach(fn func(T)) {
+	if n.collision() {
+		for _, el := range n.collisions {
+			fn(el)
+		}
+		return
+	}
+	for _, entry := range n.entries {
+		if entry.child != nil {
+			entry.child.forEach(fn)
+		} else {
+			fn(entry.value)
+		}
+	}
+}
+
+// Hamt Iterator
+
+type hamtFrame[T comparable] struct {
+	node  *hamtNode[T]
+	index int
+}
+
+type hamtIterator[T comparable] struct {
+	stack []hamtFrame[T]
+	size  int
+}
+
+func (it *hamtIterator[T]) Next() fluent.Option[T] {
+	for len(it.stack) > 0 {
+		top := &it.stack[len(it.stack)-1]
+		n := top.node
+		if n.collision() {
+			if top.index < len(n.collisions) {
+				top.index++
+				return fluent.Present(n.collisions[top.index-1])
+			}
+		} else if top.index < len(n.entries) {
+			entry := n.entries[top.index]
+			top.index++
+			if entry.child == nil {
+				return fluent.Present(entry.value)
+			}
+			it.stack = append(it.stack, hamtFrame[T]{node: entry.child})
+			continue
+		}
+		it.stack = it.stack[:len(it.stack)-1]
+	}
+	return fluent.Empty[T]()
+}
+
+// Implements iterator.Sized interface
+func (it *hamtIterator[T]) Size() fluent.Option[int] {
+	return fluent.Present(it.size)
+}
+
+// Persistent
+
+func (p *Persistent[T]) Contains(element T) bool {
+	if p.root == nil {
+		return false
+	}
+	return p.root.contains(p.hasher(element), 0, element)
+}
+
+func (p *Persistent[T]) ContainsAll(iter iterator.Iterable[T]) bool {
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		if !p.Contains(o.Get()) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Persistent[T]) Iterator() iterator.Iterator[T] {
+	it := &hamtIterator[T]{
+		size: p.size,
+	}
+	if p.root != nil {
+		it.stack = []hamtFrame[T]{{node: p.root}}
+	}
+	return it
+}
+
+func (p *Persistent[T]) Empty() bool {
+	return p.size == 0
+}
+
+func (p *Persistent[T]) Size() int {
+	return p.size
+}
+
+func (p *Persistent[T]) ForEach(fn func(T)) {
+	if p.root != nil {
+		p.root.forEach(fn)
+	}
+}
+
+func (p *Persistent[T]) ToSlice() []T {
+	return toSlice[T](p)
+}
+
+func (p *Persistent[T]) Stream() stream.Stream[T] {
+	return stream.FromIterable[T](p)
+}
+
+func (p *Persistent[T]) String() string {
+	return toString(sortedSlice[T](p))
+}
+
+// With returns a version of the set including the element. The set is
+// returned unchanged if the element is already present.
+func (p *Persistent[T]) With(element T) *Persistent[T] {
+	hash := p.hasher(element)
+	if p.root == nil {
+		return &Persistent[T]{
+			root: &hamtNode[T]{
+				bitmap:  1 << (hash & hamtMask),
+				entries: []hamtEntry[T]{{hash: hash, value: element}},
+			},
+			size:   1,
+			hasher: p.hasher,
+		}
+	}
+	root, added := p.root.with(hash, 0, element)
+	if !added {
+		return p
+	}
+	return &Persistent[T]{
+		root:   root,
+		size:   p.size + 1,
+		hasher: p.hasher,
+	}
+}
+
+// WithAll returns a version of the set including every element of the
+// provided iterable.
+func (p *Persistent[T]) WithAll(iter iterator.Iterable[T]) *Persistent[T] {
+	result := p
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		result = result.With(o.Get())
+	}
+	return result
+}
+
+// Without returns a version of the set excluding the element. The set is
+// returned unchanged if the element is not present.
+func (p *Persistent[T]) Without(element T) *Persistent[T] {
+	if p.root == nil {
+		return p
+	}
+	root, removed := p.root.without(p.hasher(element), 0, element)
+	if !removed {
+		return p
+	}
+	return &Persistent[T]{
+		root:   root,
+		size:   p.size - 1,
+		hasher: p.hasher,
+	}
+}
+
+// replace resets the set to hold only the given elements. It is only meant
+// for decoding, as it modifies the set in place.
+func (p *Persistent[T]) replace(elements []T) {
+	hasher := p.hasher
+	if hasher == nil {
+		hasher = hash.Of[T]
+	}
+	*p = *PersistentWithHasher(hasher).WithAll(iterator.ArrayIterable(elements))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p *Persistent[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(sortedSlice[T](p))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Unlike the other
+// operations, it modifies the set in place, so it must not be used on a set
+// shared with other goroutines.
+func (p *Persistent[T]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+	elements, err := decodeJSON[T](data)
+	if err != nil {
+		return err
+	}
+	p.replace(elements)
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (p *Persistent[T]) MarshalBinary() ([]byte, error) {
+	return encodeBinary(sortedSlice[T](p))
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface. Like
+// UnmarshalJSON, it modifies the set in place.
+func (p *Persistent[T]) UnmarshalBinary(data []byte) error {
+	elements, err := decodeBinary[T](data)
+	if err != nil {
+		return err
+	}
+	p.replace(elements)
+	return nil
+}
+
+// NewPersistent returns an empty Persistent set.
+func NewPersistent[T comparable]() *Persistent[T] {
+	return PersistentWithHasher(hash.Of[T])
+}
+
+// PersistentWithHasher returns an empty Persistent set using the `hasher`
+// function to place the elements in the trie. Equal elements must produce the
+// same hash.
+func PersistentWithHasher[T comparable](hasher func(T) uint64) *Persistent[T] {
+	return &Persistent[T]{
+		hasher: hasher,
+	}
+}
+
+// PersistentFromArray returns a Persistent set with the elements of the array.
+func PersistentFromArray[T comparable](arr []T) *Persistent[T] {
+	return NewPersistent[T]().WithAll(iterator.ArrayIterable(arr))
+}
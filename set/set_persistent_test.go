@@ -0,0 +1,166 @@
+package set
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/internal/hash"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ ReadOnly[int] = NewPersistent[int]()
+
+func identityHash(v int) uint64 {
+	return uint64(v)
+}
+
+func Test_Persistent_With(t *testing.T) {
+	empty := NewPersistent[string]()
+	one := empty.With("a")
+	two := one.With("b")
+
+	assert.True(t, empty.Empty(), "empty")
+	assert.Equal(t, 1, one.Size(), "one")
+	assert.True(t, one.Contains("a"), "one contains")
+	assert.False(t, one.Contains("b"), "one contains")
+	assert.Equal(t, 2, two.Size(), "two")
+	assert.True(t, two.ContainsAll(iterator.ArrayIterable([]string{"a", "b"})), "two contains")
+	assert.Same(t, two, two.With("a"), "unchanged")
+}
+
+func Test_Persistent_Without(t *testing.T) {
+	full := PersistentFromArray([]int{1, 2, 3})
+	removed := full.Without(2)
+
+	assert.Equal(t, 3, full.Size(), "original size")
+	assert.True(t, full.Contains(2), "original")
+	assert.Equal(t, 2, removed.Size(), "size")
+	assert.False(t, removed.Contains(2), "removed")
+	assert.Same(t, removed, removed.Without(2), "unchanged")
+
+	empty := NewPersistent[int]()
+	assert.Same(t, empty, empty.Without(1), "empty")
+	assert.True(t, empty.With(1).Without(1).Empty(), "emptied")
+}
+
+func Test_Persistent_structuralSharing(t *testing.T) {
+	p := PersistentWithHasher(identityHash)
+	for i := 0; i < 1024; i++ {
+		p = p.With(i)
+	}
+	next := p.With(1024)
+
+	assert.NotSame(t, p.root.entries[0].child, next.root.entries[0].child, "modified path")
+	for i := 1; i < hamtWidth; i++ {
+		assert.Same(t, p.root.entries[i].child, next.root.entries[i].child, "shared %d", i)
+	}
+}
+
+func Test_Persistent_random(t *testing.T) {
+	hashers := map[string]func(int) uint64{
+		"default":   hash.Of[int],
+		"identity":  identityHash,
+		"colliding": func(v int) uint64 { return uint64(v % 4) },
+	}
+	for name, hasher := range hashers {
+		r := rand.New(rand.NewSource(7))
+		p := PersistentWithHasher(hasher)
+		expected := make(map[int]bool)
+		var versions []*Persistent[int]
+		var snapshots []map[int]bool
+
+		for i := 0; i < 3000; i++ {
+			v := r.Intn(300)
+			if r.Intn(3) == 0 {
+				p = p.Without(v)
+				delete(expected, v)
+			} else {
+				p = p.With(v)
+				expected[v] = true
+			}
+			if i%500 == 0 {
+				snapshot := make(map[int]bool, len(expected))
+				for k := range expected {
+					snapshot[k] = true
+				}
+				versions = append(versions, p)
+				snapshots = append(snapshots, snapshot)
+			}
+		}
+
+		versions = append(versions, p)
+		snapshots = append(snapshots, expected)
+		for i, version := range versions {
+			var keys []int
+			for k := range snapshots[i] {
+				keys = append(keys, k)
+				assert.True(t, version.Contains(k), "%s contains %d", name, k)
+			}
+			assert.Equal(t, len(keys), version.Size(), "%s size", name)
+			assert.ElementsMatch(t, keys, drain(version.Iterator()), "%s iterator", name)
+			var visited []int
+			version.ForEach(func(v int) {
+				visited = append(visited, v)
+			})
+			assert.ElementsMatch(t, keys, visited, "%s ForEach", name)
+		}
+		for v := 0; v < 300; v++ {
+			assert.Equal(t, expected[v], p.Contains(v), "%s contains %d", name, v)
+		}
+	}
+}
+
+func Test_Persistent_collisions(t *testing.T) {
+	p := PersistentWithHasher(func(int) uint64 { return 42 })
+	p = p.WithAll(iterator.ArrayIterable([]int{1, 2, 3}))
+
+	assert.Equal(t, 3, p.Size(), "size")
+	assert.ElementsMatch(t, []int{1, 2, 3}, drain(p.Iterator()))
+
+	p = p.Without(2).Without(1)
+	assert.Equal(t, []int{3}, drain(p.Iterator()), "inlined")
+	assert.Nil(t, p.root.entries[0].child, "inlined")
+
+	p = p.Without(3)
+	assert.True(t, p.Empty(), "empty")
+	assert.Nil(t, p.root, "root")
+}
+
+func Test_Persistent_Iterator(t *testing.T) {
+	p := PersistentFromArray([]int{5, 6, 7})
+
+	assert.Equal(t, fluent.Present(3), iterator.Size(p.Iterator()), "size")
+	assert.ElementsMatch(t, []int{5, 6, 7}, stream.FromIterable[int](p).Array(), "stream")
+	assert.Empty(t, drain(NewPersistent[int]().Iterator()), "empty")
+}
+
+func Test_Persistent_ToSlice(t *testing.T) {
+	p := PersistentFromArray([]int{3, 1, 2})
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, p.ToSlice())
+	assert.Equal(t, "Set[1 2 3]", p.String())
+	assert.Equal(t, 3, p.Stream().Count())
+}
+
+func Test_Persistent_pointer(t *testing.T) {
+	p := &hashTestPoint{1, 2}
+	s := NewPersistent[*hashTestPoint]().With(p)
+	p.X = 2
+
+	assert.True(t, s.Contains(p), "found after the pointee changed")
+	assert.Same(t, s, s.With(p), "unchanged")
+	assert.True(t, s.Without(p).Empty(), "removed")
+}
+
+func Test_Persistent_signedZero(t *testing.T) {
+	s := NewPersistent[hashTestPoint]().
+		With(hashTestPoint{0, 1}).
+		With(hashTestPoint{math.Copysign(0, -1), 1})
+
+	assert.Equal(t, 1, s.Size())
+	assert.True(t, s.Contains(hashTestPoint{0, 1}))
+}
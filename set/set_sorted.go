@@ -0,0 +1,239 @@
+package set
+
+import (
+	"encoding/json"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/internal/avl"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/stream"
+)
+
+// SortedSet is a Set which keeps its elements ordered according to a
+// comparator function, iterating over them in ascending order.
+type SortedSet[T any] interface {
+	Set[T]
+
+	// First returns the lowest element of the set, if any.
+	First() fluent.Option[T]
+	// Last returns the highest element of the set, if any.
+	Last() fluent.Option[T]
+	// Floor returns the greatest element of the set lower than or equal to
+	// `element`, if any.
+	Floor(element T) fluent.Option[T]
+	// Ceiling returns the least element of the set greater than or equal to
+	// `element`, if any.
+	Ceiling(element T) fluent.Option[T]
+	// Range returns an iterator, in ascending order, over the elements of the
+	// set from `from`, inclusive, to `to`, exclusive.
+	Range(from, to T) iterator.Iterator[T]
+	// Descending returns an iterator over the elements of the set in
+	// descending order.
+	Descending() iterator.Iterator[T]
+}
+
+// sortedSet is backed by an AVL tree mapping each element to nothing.
+type sortedSet[T any] struct {
+	tree *avl.Tree[T, struct{}]
+}
+
+func (s *sortedSet[T]) Contains(element T) bool {
+	return s.tree.Contains(element)
+}
+
+func (s *sortedSet[T]) ContainsAll(iter iterator.Iterable[T]) bool {
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		if !s.tree.Contains(o.Get()) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *sortedSet[T]) Add(element T) bool {
+	return !s.tree.Put(element, struct{}{}).IsPresent()
+}
+
+func (s *sortedSet[T]) AddAll(iter iterator.Iterable[T]) {
+	it := iter.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		s.Add(o.Get())
+	}
+}
+
+func (s *sortedSet[T]) Remove(element T) bool {
+	return s.tree.Remove(element).IsPresent()
+}
+
+func (s *sortedSet[T]) Clear() {
+	s.tree.Clear()
+}
+
+func (s *sortedSet[T]) Clone() Set[T] {
+	return &sortedSet[T]{
+		tree: s.tree.Clone(),
+	}
+}
+
+func (s *sortedSet[T]) Filter(condition func(T) bool) Set[T] {
+	return filter[T](s, condition, s.empty())
+}
+
+func (s *sortedSet[T]) ToSlice() []T {
+	return toSlice[T](s)
+}
+
+func (s *sortedSet[T]) Stream() stream.Stream[T] {
+	return stream.FromIterable[T](s)
+}
+
+func (s *sortedSet[T]) String() string {
+	return toString(toSlice[T](s))
+}
+
+func (s *sortedSet[T]) Empty() bool {
+	return s.tree.Len() == 0
+}
+
+func (s *sortedSet[T]) Size() int {
+	return s.tree.Len()
+}
+
+func (s *sortedSet[T]) ForEach(fn func(T)) {
+	s.tree.ForEach(func(el T, _ struct{}) {
+		fn(el)
+	})
+}
+
+func key[T any](o fluent.Option[avl.Entry[T, struct{}]]) fluent.Option[T] {
+	return fluent.MapOption(o, func(e avl.Entry[T, struct{}]) T {
+		return e.Key
+	})
+}
+
+func (s *sortedSet[T]) First() fluent.Option[T] {
+	return key(s.tree.Min())
+}
+
+func (s *sortedSet[T]) Last() fluent.Option[T] {
+	return key(s.tree.Max())
+}
+
+func (s *sortedSet[T]) Floor(element T) fluent.Option[T] {
+	return key(s.tree.Floor(element))
+}
+
+func (s *sortedSet[T]) Ceiling(element T) fluent.Option[T] {
+	return key(s.tree.Ceiling(element))
+}
+
+func (s *sortedSet[T]) Iterator() iterator.Iterator[T] {
+	return avl.Keys(s.tree.Ascending())
+}
+
+func (s *sortedSet[T]) Descending() iterator.Iterator[T] {
+	return avl.Keys(s.tree.Descending())
+}
+
+func (s *sortedSet[T]) Range(from, to T) iterator.Iterator[T] {
+	return avl.Keys(s.tree.Range(from, to))
+}
+
+func (s *sortedSet[T]) empty() *sortedSet[T] {
+	return &sortedSet[T]{
+		tree: avl.New[T, struct{}](s.tree.Compare()),
+	}
+}
+
+func (s *sortedSet[T]) Union(other Set[T]) Set[T] {
+	return union[T](s, other, s.empty())
+}
+
+func (s *sortedSet[T]) Intersection(other Set[T]) Set[T] {
+	return intersection[T](s, other, s.empty())
+}
+
+func (s *sortedSet[T]) Difference(other Set[T]) Set[T] {
+	return difference[T](s, other, s.empty())
+}
+
+func (s *sortedSet[T]) SymmetricDifference(other Set[T]) Set[T] {
+	return symmetricDifference[T](s, other, s.empty())
+}
+
+func (s *sortedSet[T]) IsSubsetOf(other Set[T]) bool {
+	return isSubset[T](s, other)
+}
+
+func (s *sortedSet[T]) IsSupersetOf(other Set[T]) bool {
+	return isSubset[T](other, s)
+}
+
+func (s *sortedSet[T]) IsDisjoint(other Set[T]) bool {
+	return isDisjoint[T](s, other)
+}
+
+func (s *sortedSet[T]) Equal(other Set[T]) bool {
+	return equal[T](s, other)
+}
+
+func (s *sortedSet[T]) RetainAll(other Set[T]) {
+	retainAll[T](s, other)
+}
+
+func (s *sortedSet[T]) RemoveAll(iter iterator.Iterable[T]) {
+	removeAll[T](s, iter)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s *sortedSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(toSlice[T](s))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *sortedSet[T]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+	elements, err := decodeJSON[T](data)
+	if err != nil {
+		return err
+	}
+	replace[T](s, elements)
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (s *sortedSet[T]) MarshalBinary() ([]byte, error) {
+	return encodeBinary(toSlice[T](s))
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (s *sortedSet[T]) UnmarshalBinary(data []byte) error {
+	elements, err := decodeBinary[T](data)
+	if err != nil {
+		return err
+	}
+	replace[T](s, elements)
+	return nil
+}
+
+// NewSorted creates an empty SortedSet ordered by the `compare` function,
+// which must return a negative number if `a` is lower than `b`, zero if they
+// are equal and a positive number if `a` is greater than `b`.
+//
+// Elements for which `compare` returns zero are considered the same element.
+func NewSorted[T any](compare func(a, b T) int) SortedSet[T] {
+	return &sortedSet[T]{
+		tree: avl.New[T, struct{}](compare),
+	}
+}
+
+// SortedFromArray creates a SortedSet ordered by the `compare` function with
+// the elements of the array.
+func SortedFromArray[T any](arr []T, compare func(a, b T) int) SortedSet[T] {
+	set := NewSorted(compare)
+	set.AddAll(iterator.ArrayIterable(arr))
+	return set
+}
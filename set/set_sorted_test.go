@@ -0,0 +1,136 @@
+package set
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func compareInts(a, b int) int {
+	return a - b
+}
+
+func drain[T any](it iterator.Iterator[T]) []T {
+	var out []T
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		out = append(out, o.Get())
+	}
+	return out
+}
+
+func Test_SortedSet_order(t *testing.T) {
+	s := SortedFromArray([]int{5, 3, 9, 1, 7, 3, 5}, compareInts)
+
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, elements[int](s), "ForEach")
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, stream.FromIterable[int](s).Array(), "Iterator")
+	assert.Equal(t, []int{9, 7, 5, 3, 1}, drain(s.Descending()), "Descending")
+	assert.Equal(t, 5, s.Size(), "size")
+}
+
+func Test_SortedSet_random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	s := NewSorted(compareInts)
+	expected := make(map[int]bool)
+
+	for i := 0; i < 5000; i++ {
+		v := r.Intn(500)
+		if r.Intn(3) == 0 {
+			s.Remove(v)
+			delete(expected, v)
+		} else {
+			s.Add(v)
+			expected[v] = true
+		}
+	}
+
+	keys := make([]int, 0, len(expected))
+	for k := range expected {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	assert.Equal(t, keys, drain(s.Iterator()), "elements")
+	assert.Equal(t, len(keys), s.Size(), "size")
+}
+
+func Test_SortedSet_FirstLast(t *testing.T) {
+	s := SortedFromArray([]int{8, 2, 5}, compareInts)
+
+	assert.Equal(t, fluent.Present(2), s.First())
+	assert.Equal(t, fluent.Present(8), s.Last())
+
+	empty := NewSorted(compareInts)
+	assert.False(t, empty.First().IsPresent(), "first")
+	assert.False(t, empty.Last().IsPresent(), "last")
+}
+
+func Test_SortedSet_FloorCeiling(t *testing.T) {
+	s := SortedFromArray([]int{10, 20, 30, 40}, compareInts)
+
+	assert.Equal(t, fluent.Present(20), s.Floor(25), "floor")
+	assert.Equal(t, fluent.Present(20), s.Floor(20), "floor exact")
+	assert.False(t, s.Floor(5).IsPresent(), "floor absent")
+	assert.Equal(t, fluent.Present(30), s.Ceiling(25), "ceiling")
+	assert.Equal(t, fluent.Present(30), s.Ceiling(30), "ceiling exact")
+	assert.False(t, s.Ceiling(45).IsPresent(), "ceiling absent")
+}
+
+func Test_SortedSet_Range(t *testing.T) {
+	s := SortedFromArray([]int{10, 20, 30, 40, 50}, compareInts)
+
+	assert.Equal(t, []int{20, 30, 40}, drain(s.Range(20, 50)), "inclusive from")
+	assert.Equal(t, []int{20, 30}, drain(s.Range(15, 35)), "between")
+	assert.Empty(t, drain(s.Range(51, 60)), "after")
+	assert.Empty(t, drain(s.Range(30, 30)), "empty")
+	assert.Equal(t, []int{10, 20, 30, 40, 50}, drain(s.Range(0, 100)), "all")
+	assert.False(t, iterator.Size(s.Range(0, 100)).IsPresent(), "size")
+}
+
+func Test_SortedSet_comparator(t *testing.T) {
+	s := NewSorted(func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	s.Add("b")
+	s.Add("A")
+	s.Add("a")
+	s.Add("C")
+
+	assert.Equal(t, []string{"a", "b", "C"}, elements[string](s))
+	assert.True(t, s.Contains("c"), "contains")
+}
+
+func Test_SortedSet_Contains(t *testing.T) {
+	s := SortedFromArray([]int{8, 2, 5}, compareInts)
+
+	assert.True(t, s.Contains(2))
+	assert.False(t, s.Contains(3))
+	assert.True(t, s.ContainsAll(iterator.ArrayIterable([]int{8, 5})))
+	assert.False(t, s.ContainsAll(iterator.ArrayIterable([]int{8, 6})))
+	assert.Equal(t, fluent.Present(3), iterator.Size(s.Iterator()), "size")
+}
+
+func Test_SortedSet_algebra(t *testing.T) {
+	a := SortedFromArray([]int{4, 3, 2, 1}, compareInts)
+	b := SortedFromArray([]int{6, 5, 3, 4}, compareInts)
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, elements(a.Union(b)), "union")
+	assert.Equal(t, []int{3, 4}, elements(a.Intersection(b)), "intersection")
+	assert.Equal(t, []int{1, 2}, elements(a.Difference(b)), "difference")
+	assert.Equal(t, []int{1, 2, 5, 6}, elements(a.SymmetricDifference(b)), "symmetric")
+	assert.True(t, a.IsSubsetOf(FromArray([]int{1, 2, 3, 4, 5})), "subset")
+	assert.True(t, a.IsSupersetOf(FromArray([]int{1, 2})), "superset")
+	assert.True(t, a.IsDisjoint(FromArray([]int{7, 8})), "disjoint")
+	assert.True(t, a.Equal(FromArray([]int{1, 2, 3, 4})), "equal")
+
+	a.RetainAll(b)
+	assert.Equal(t, []int{3, 4}, elements[int](a), "retain")
+
+	a.RemoveAll(iterator.ArrayIterable([]int{4}))
+	assert.Equal(t, []int{3}, elements[int](a), "remove")
+}
@@ -35,3 +35,132 @@ func Test_FromIterable(t *testing.T) {
 		assert.True(t, s.Contains(val))
 	}
 }
+
+// implementations returns a function creating an empty set for each Set
+// implementation.
+func implementations() map[string]func() Set[int] {
+	return map[string]func() Set[int]{
+		"map":        func() Set[int] { return New[int]() },
+		"keyed":      func() Set[int] { return byTens() },
+		"hash":       func() Set[int] { return hashSetOf() },
+		"concurrent": func() Set[int] { return concurrentOf() },
+		"linked":     func() Set[int] { return NewLinked[int]() },
+		"sorted":     func() Set[int] { return NewSorted(compareInts) },
+		"bits":       func() Set[int] { return NewBitSet() },
+		"compressed": func() Set[int] { return NewCompressedBitSet() },
+	}
+}
+
+func Test_Set_Add_Remove(t *testing.T) {
+	for name, create := range implementations() {
+		s := create()
+		assert.True(t, s.Add(1), "%s: added", name)
+		assert.False(t, s.Add(1), "%s: present", name)
+		assert.True(t, s.Remove(1), "%s: removed", name)
+		assert.False(t, s.Remove(1), "%s: absent", name)
+		assert.True(t, s.Empty(), name)
+	}
+}
+
+func Test_Set_Clear(t *testing.T) {
+	for name, create := range implementations() {
+		s := create()
+		s.AddAll(iterator.ArrayIterable([]int{1, 2, 100}))
+		s.Clear()
+		assert.True(t, s.Empty(), name)
+		assert.False(t, s.Contains(1), name)
+
+		s.Add(2)
+		assert.Equal(t, []int{2}, elements(s), "%s: reusable", name)
+	}
+}
+
+func Test_Set_Clone(t *testing.T) {
+	for name, create := range implementations() {
+		s := create()
+		s.AddAll(iterator.ArrayIterable([]int{1, 2, 3}))
+		c := s.Clone()
+		assert.IsType(t, s, c, name)
+		assert.True(t, c.Equal(s), name)
+
+		c.Add(4)
+		c.Remove(1)
+		assert.ElementsMatch(t, []int{1, 2, 3}, elements(s), "%s: independent", name)
+		assert.ElementsMatch(t, []int{2, 3, 4}, elements(c), name)
+	}
+}
+
+func Test_Set_Filter(t *testing.T) {
+	for name, create := range implementations() {
+		s := create()
+		s.AddAll(iterator.ArrayIterable([]int{1, 2, 3, 4}))
+		even := s.Filter(func(v int) bool {
+			return v%2 == 0
+		})
+		assert.IsType(t, s, even, name)
+		assert.ElementsMatch(t, []int{2, 4}, elements(even), name)
+		assert.Equal(t, 4, s.Size(), "%s: unchanged", name)
+	}
+}
+
+func Test_Set_ToSlice(t *testing.T) {
+	for name, create := range implementations() {
+		s := create()
+		s.AddAll(iterator.ArrayIterable([]int{3, 1, 2}))
+		assert.ElementsMatch(t, []int{1, 2, 3}, s.ToSlice(), name)
+		assert.Equal(t, []int{}, create().ToSlice(), "%s: empty", name)
+	}
+}
+
+func Test_Set_Stream(t *testing.T) {
+	for name, create := range implementations() {
+		s := create()
+		s.AddAll(iterator.ArrayIterable([]int{1, 2, 3, 4}))
+		actual := s.Stream().Filter(func(v int) bool {
+			return v > 2
+		}).Array()
+		assert.ElementsMatch(t, []int{3, 4}, actual, name)
+	}
+}
+
+func Test_Set_String(t *testing.T) {
+	for name, create := range implementations() {
+		s := create()
+		s.AddAll(iterator.ArrayIterable([]int{1, 3, 2}))
+		if name == "linked" {
+			assert.Equal(t, "Set[1 3 2]", s.String(), "insertion order")
+		} else {
+			assert.Equal(t, "Set[1 2 3]", s.String(), name)
+		}
+	}
+	assert.Equal(t, "Set[]", New[int]().String(), "empty")
+}
+
+func Test_FromIterable_sized(t *testing.T) {
+	s := FromIterable[int](New[int]())
+	assert.True(t, s.Empty(), "empty")
+
+	s = FromIterable(iterator.ArrayIterable(make([]int, 1000)))
+	assert.Equal(t, []int{0}, elements(s))
+}
+
+func Test_Map(t *testing.T) {
+	s := FromArray([]int{-2, -1, 1, 2, 3})
+	abs := Map(s, func(v int) int {
+		if v < 0 {
+			return -v
+		}
+		return v
+	})
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, elements(abs))
+}
+
+func Test_Map_type(t *testing.T) {
+	s := SortedFromArray([]int{1, 2}, compareInts)
+	names := Map[int, string](s, func(v int) string {
+		return string(rune('a' + v))
+	})
+
+	assert.ElementsMatch(t, []string{"b", "c"}, elements(names))
+}
@@ -0,0 +1,43 @@
+package stream
+
+import "time"
+
+// Clock provides the current time and timers to the time based stream
+// operations, allowing them to be driven by a fake time source.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After returns a channel which receives the current time once the
+	// duration `d` has elapsed.
+	After(d time.Duration) <-chan time.Time
+
+	// Sleep pauses the current goroutine for at least the duration `d`.
+	Sleep(d time.Duration)
+}
+
+type systemClock struct{}
+
+// SystemClock returns a Clock backed by the `time` package.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (systemClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+func clockOrSystem(clock Clock) Clock {
+	if clock == nil {
+		return SystemClock()
+	}
+	return clock
+}
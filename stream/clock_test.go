@@ -0,0 +1,144 @@
+package stream
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is a Clock whose time only moves when advanced by the test.
+type fakeClock struct {
+	lock   sync.Mutex
+	cond   *sync.Cond
+	now    time.Time
+	timers []fakeTimer
+	calls  int
+}
+
+type fakeTimer struct {
+	deadline time.Time
+	ch       chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	c := &fakeClock{now: time.Unix(0, 0)}
+	c.cond = sync.NewCond(&c.lock)
+	return c
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	ch := make(chan time.Time, 1)
+	c.timers = append(c.timers, fakeTimer{c.now.Add(d), ch})
+	c.calls++
+	c.cond.Broadcast()
+	c.fire()
+	return ch
+}
+
+func (c *fakeClock) Sleep(d time.Duration) {
+	c.Advance(d)
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.now = c.now.Add(d)
+	c.fire()
+}
+
+func (c *fakeClock) fire() {
+	pending := c.timers[:0]
+	for _, timer := range c.timers {
+		if timer.deadline.After(c.now) {
+			pending = append(pending, timer)
+		} else {
+			timer.ch <- c.now
+		}
+	}
+	c.timers = pending
+}
+
+// WaitForTimers blocks until `After` has been called `n` times.
+func (c *fakeClock) WaitForTimers(n int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for c.calls < n {
+		c.cond.Wait()
+	}
+}
+
+// steppedSource is an iterator whose elements are handed over by the test one
+// at a time.
+type steppedSource struct {
+	requests chan struct{}
+	values   chan fluent.Option[int]
+}
+
+func newSteppedSource() *steppedSource {
+	return &steppedSource{
+		requests: make(chan struct{}),
+		values:   make(chan fluent.Option[int]),
+	}
+}
+
+func (s *steppedSource) Iterator() iterator.Iterator[int] {
+	return iterator.Func(func() fluent.Option[int] {
+		s.requests <- struct{}{}
+		return <-s.values
+	})
+}
+
+// Push hands `v` to the consumer and waits until it was received.
+func (s *steppedSource) Push(v int) {
+	s.values <- fluent.Present(v)
+	<-s.requests
+}
+
+// Start waits until the consumer requests its first element.
+func (s *steppedSource) Start() {
+	<-s.requests
+}
+
+func (s *steppedSource) Close() {
+	s.values <- fluent.Empty[int]()
+}
+
+// drain consumes the iterator in a separate goroutine.
+func drain[T any](it iterator.Iterator[T]) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for o := it.Next(); o.IsPresent(); o = it.Next() {
+			out <- o.Get()
+		}
+	}()
+	return out
+}
+
+func Test_SystemClock(t *testing.T) {
+	clock := SystemClock()
+	start := clock.Now()
+	clock.Sleep(time.Millisecond)
+	<-clock.After(time.Millisecond)
+
+	assert.True(t, clock.Now().Sub(start) >= 2*time.Millisecond, "elapsed")
+}
+
+func Test_clockOrSystem(t *testing.T) {
+	clock := newFakeClock()
+
+	assert.Equal(t, SystemClock(), clockOrSystem(nil))
+	assert.Same(t, clock, clockOrSystem(clock))
+}
@@ -0,0 +1,147 @@
+package stream
+
+import (
+	"sync"
+
+	"github.com/mikhasd/fluent/iterator"
+)
+
+// Sink receives the elements of a stream.
+type Sink[T any] interface {
+	// Accept receives the next element of the stream.
+	Accept(T)
+}
+
+// Collector accumulates the elements of a stream into a result.
+type Collector[T any, R any] interface {
+	Sink[T]
+
+	// Result returns the result of the elements accumulated so far.
+	Result() R
+}
+
+// broadcastBuffer is the number of elements Broadcast buffers for the sinks
+// lagging behind.
+const broadcastBuffer = 64
+
+// Collect feeds every element of the stream to the collector and returns its
+// result.
+func Collect[T any, R any](s Stream[T], collector Collector[T, R]) R {
+	it := s.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		collector.Accept(o.Get())
+	}
+	return collector.Result()
+}
+
+// Broadcast feeds every element of the stream to each of the provided sinks,
+// consuming the stream in a single pass.
+//
+// Each sink receives the elements in order from its own goroutine, so a slow
+// sink does not hold back the others until it falls too far behind. Broadcast
+// returns once every sink received all the elements.
+func Broadcast[T any](s Stream[T], sinks ...Sink[T]) {
+	if len(sinks) == 0 {
+		s.Count()
+		return
+	}
+	iterators := iterator.TeeBounded(s.Iterator(), len(sinks), broadcastBuffer)
+	var wg sync.WaitGroup
+	wg.Add(len(sinks))
+	for i, sink := range sinks {
+		go func(it iterator.Iterator[T], sink Sink[T]) {
+			defer wg.Done()
+			for o := it.Next(); o.IsPresent(); o = it.Next() {
+				sink.Accept(o.Get())
+			}
+		}(iterators[i], sink)
+	}
+	wg.Wait()
+}
+
+// Counting
+
+type counting[T any] struct {
+	count int
+}
+
+func (c *counting[T]) Accept(T) {
+	c.count++
+}
+
+func (c *counting[T]) Result() int {
+	return c.count
+}
+
+// Counting returns a Collector counting the number of elements.
+func Counting[T any]() Collector[T, int] {
+	return &counting[T]{}
+}
+
+// To Array
+
+type toArray[T any] struct {
+	items []T
+}
+
+func (a *toArray[T]) Accept(item T) {
+	a.items = append(a.items, item)
+}
+
+func (a *toArray[T]) Result() []T {
+	return a.items
+}
+
+// ToArray returns a Collector gathering the elements into an array.
+func ToArray[T any]() Collector[T, []T] {
+	return &toArray[T]{}
+}
+
+// Grouping By
+
+type groupingBy[T any, K comparable] struct {
+	key    func(T) K
+	groups map[K][]T
+}
+
+func (g *groupingBy[T, K]) Accept(item T) {
+	key := g.key(item)
+	g.groups[key] = append(g.groups[key], item)
+}
+
+func (g *groupingBy[T, K]) Result() map[K][]T {
+	return g.groups
+}
+
+// GroupingBy returns a Collector grouping the elements by the `key` computed
+// for each of them.
+func GroupingBy[T any, K comparable](key func(T) K) Collector[T, map[K][]T] {
+	return &groupingBy[T, K]{
+		key:    key,
+		groups: make(map[K][]T),
+	}
+}
+
+// Reducing
+
+type reducing[T any] struct {
+	result  T
+	reducer func(T, T) T
+}
+
+func (r *reducing[T]) Accept(item T) {
+	r.result = r.reducer(r.result, item)
+}
+
+func (r *reducing[T]) Result() T {
+	return r.result
+}
+
+// Reducing returns a Collector combining the elements with the `reducer`
+// function, starting from the `identity` value.
+func Reducing[T any](identity T, reducer func(T, T) T) Collector[T, T] {
+	return &reducing[T]{
+		result:  identity,
+		reducer: reducer,
+	}
+}
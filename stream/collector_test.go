@@ -0,0 +1,67 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Collect(t *testing.T) {
+	actual := Collect(FromArray(streamTestData), ToArray[int]())
+
+	assert.Equal(t, streamTestData, actual)
+}
+
+func Test_Counting(t *testing.T) {
+	actual := Collect(FromArray(streamTestData), Counting[int]())
+
+	assert.Equal(t, len(streamTestData), actual)
+}
+
+func Test_GroupingBy(t *testing.T) {
+	actual := Collect(FromArray(streamTestData), GroupingBy(func(i int) bool {
+		return i%2 == 0
+	}))
+
+	expected := map[bool][]int{
+		false: {1, 3, 5, 7, 9},
+		true:  {2, 4, 6, 8, 10},
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func Test_Reducing(t *testing.T) {
+	actual := Collect(FromArray(streamTestData), Reducing(0, func(a, b int) int {
+		return a + b
+	}))
+
+	assert.Equal(t, 55, actual)
+}
+
+func Test_Broadcast(t *testing.T) {
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = i
+	}
+	count := Counting[int]()
+	groups := GroupingBy(func(i int) int {
+		return i % 3
+	})
+	arr := ToArray[int]()
+
+	Broadcast[int](FromArray(data), count, groups, arr)
+
+	assert.Equal(t, len(data), count.Result(), "count")
+	assert.Len(t, groups.Result(), 3, "groups")
+	assert.Len(t, groups.Result()[0], 334, "group")
+	assert.Equal(t, data, arr.Result(), "array")
+}
+
+func Test_Broadcast_noSinks(t *testing.T) {
+	peeked := 0
+	Broadcast(FromArray(streamTestData).Peek(func(int) {
+		peeked++
+	}))
+
+	assert.Equal(t, len(streamTestData), peeked)
+}
@@ -1,6 +1,8 @@
 package stream
 
 import (
+	"fmt"
+
 	"github.com/mikhasd/fluent"
 	"github.com/mikhasd/fluent/iterator"
 )
@@ -8,7 +10,9 @@ import (
 // Stream is a lazy sequence of elements of a generic type that can be
 // manipulated thru a pipeline of operations.
 //
-// A stream pipeline can be used only once.
+// A stream pipeline can be used only once: applying an operation to a stream
+// which was already linked to another stage or consumed panics. Use Tee to
+// feed the same elements to more than one pipeline.
 type Stream[T any] interface {
 	// Skip discards the first `n` elements of the stream.
 	Skip(n int) Stream[T]
@@ -50,12 +54,32 @@ type Stream[T any] interface {
 	While(func(T) bool) Stream[T]
 
 	Parallel() Stream[T]
+
+	// Tee returns `n` streams emitting the same elements as this stream. The
+	// elements not yet consumed by every stream are kept in a shared buffer.
+	Tee(n int) []Stream[T]
+
+	// Describe renders the chain of stages of the stream pipeline, from the
+	// data source to the current stage, along with the expected size and the
+	// number of elements received and emitted by each stage.
+	Describe() string
+
+	// Stats returns the counters of each stage of the stream pipeline, from the
+	// data source to the current stage.
+	Stats() []StageStats
+
+	// Trace registers a `tracer` function to be called with every element
+	// emitted by each stage of the stream pipeline. The stages of a parallel
+	// stream call it from several goroutines, so it must then be safe for
+	// concurrent use.
+	//
+	// Trace panics if the stream was already linked or consumed.
+	Trace(tracer func(TraceEvent)) Stream[T]
 }
 
 // FromArray creates a stream with an array as data source.
 func FromArray[T any](arr []T) Stream[T] {
-	it := iterator.FromArray(arr)
-	return FromIterator(it)
+	return source("FromArray", iterator.FromArray(arr))
 }
 
 // Of creates a stream with the given elements.
@@ -65,14 +89,18 @@ func Of[T any](items ...T) Stream[T] {
 
 // FromIterable creates a stream from an iterator.Iterable.
 func FromIterable[T any](iter iterator.Iterable[T]) Stream[T] {
-	return FromIterator(iter.Iterator())
+	return source("FromIterable", iter.Iterator())
 }
 
 // FromIterator cerates a stream from an iterator.Iterator.
 func FromIterator[T any](it iterator.Iterator[T]) Stream[T] {
-	return &iteratorStream[T]{
-		iterator: it,
-	}
+	return source("FromIterator", it)
+}
+
+// FromChannel creates a stream receiving the elements sent to the given
+// channel. The stream finishes when the channel is closed.
+func FromChannel[T any](ch <-chan T) Stream[T] {
+	return source("FromChannel", iterator.FromChannel(ch))
 }
 
 // Map applies the `mapper` function to the elements of the source stream and
@@ -81,10 +109,11 @@ func FromIterator[T any](it iterator.Iterator[T]) Stream[T] {
 // This function is useful if the input and output types of the mapper function
 // are different.
 func Map[A any, R any](s Stream[A], mapper func(A) R) Stream[R] {
-	it := s.Iterator()
-	return FromIterator(iterator.Func(func() fluent.Option[R] {
-		return fluent.MapOption(it.Next(), mapper)
-	}))
+	return link(s, "Map", func(it iterator.Iterator[A]) iterator.Iterator[R] {
+		return iterator.Func(func() fluent.Option[R] {
+			return fluent.MapOption(it.Next(), mapper)
+		})
+	})
 }
 
 // MapArray is a shortcut to create a stream with the input array and apply
@@ -98,3 +127,29 @@ func Map[A any, R any](s Stream[A], mapper func(A) R) Stream[R] {
 func MapArray[I any, O any](in []I, mapper func(I) O) Stream[O] {
 	return Map(FromArray(in), mapper)
 }
+
+// Chunk returns a stream of arrays holding `size` consecutive elements of the
+// source stream. The last array may be shorter if the number of elements is
+// not a multiple of `size`.
+func Chunk[T any](s Stream[T], size int) Stream[[]T] {
+	return link(s, fmt.Sprintf("Chunk(%d)", size), func(it iterator.Iterator[T]) iterator.Iterator[[]T] {
+		return iterator.Chunk(it, size)
+	})
+}
+
+// Window returns a stream of sliding windows of `size` elements over the
+// source stream, each window starting `step` elements after the previous one.
+// Incomplete trailing windows are discarded.
+func Window[T any](s Stream[T], size, step int) Stream[[]T] {
+	return link(s, fmt.Sprintf("Window(%d, %d)", size, step), func(it iterator.Iterator[T]) iterator.Iterator[[]T] {
+		return iterator.Window(it, size, step)
+	})
+}
+
+// ChunkBy returns a stream of arrays grouping the consecutive elements of the
+// source stream that share the same `key`.
+func ChunkBy[T any, K comparable](s Stream[T], key func(T) K) Stream[[]T] {
+	return link(s, "ChunkBy", func(it iterator.Iterator[T]) iterator.Iterator[[]T] {
+		return iterator.ChunkBy(it, key)
+	})
+}
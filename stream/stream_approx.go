@@ -0,0 +1,41 @@
+package stream
+
+import (
+	"fmt"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/set/probabilistic"
+)
+
+// DistinctApprox returns a stream discarding the elements of the source stream
+// already seen, tracked by a Bloom filter sized for `expected` distinct
+// elements and a false positive rate of `rate`.
+//
+// Every duplicate is discarded, but distinct elements may also be discarded
+// with a probability close to `rate`.
+func DistinctApprox[T comparable](s Stream[T], expected int, rate float64) Stream[T] {
+	seen := probabilistic.NewBloom[T](expected, rate)
+	name := fmt.Sprintf("DistinctApprox(%d, %g)", expected, rate)
+	return link(s, name, func(it iterator.Iterator[T]) iterator.Iterator[T] {
+		return iterator.Func(func() fluent.Option[T] {
+			for o := it.Next(); o.IsPresent(); o = it.Next() {
+				if seen.Add(o.Get()) {
+					return o
+				}
+			}
+			return fluent.Empty[T]()
+		})
+	})
+}
+
+// CountDistinctApprox returns the estimated number of distinct elements of
+// the stream, computed by a HyperLogLog counter with 2^precision registers.
+func CountDistinctApprox[T comparable](s Stream[T], precision int) int {
+	counter := probabilistic.NewHyperLogLog[T](precision)
+	it := s.Iterator()
+	for o := it.Next(); o.IsPresent(); o = it.Next() {
+		counter.Add(o.Get())
+	}
+	return counter.Count()
+}
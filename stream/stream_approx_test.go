@@ -0,0 +1,41 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DistinctApprox(t *testing.T) {
+	s := DistinctApprox(Of(1, 2, 1, 3, 2, 4), 100, 0.001)
+
+	assert.Equal(t, []int{1, 2, 3, 4}, s.Array())
+}
+
+func Test_DistinctApprox_large(t *testing.T) {
+	data := make([]int, 20000)
+	for i := range data {
+		data[i] = i % 10000
+	}
+	count := DistinctApprox(FromArray(data), 10000, 0.01).Count()
+
+	assert.InDelta(t, 10000, count, 200)
+}
+
+func Test_DistinctApprox_Describe(t *testing.T) {
+	s := DistinctApprox(Of(1, 1, 2), 10, 0.01)
+	s.Count()
+
+	expected := "FromArray [size=3, in=3, out=3]\n -> DistinctApprox(10, 0.01) [size=?, in=3, out=2]"
+	assert.Equal(t, expected, s.Describe())
+}
+
+func Test_CountDistinctApprox(t *testing.T) {
+	data := make([]int, 50000)
+	for i := range data {
+		data[i] = i % 20000
+	}
+	count := CountDistinctApprox(FromArray(data), 14)
+
+	assert.InEpsilon(t, 20000, count, 0.03)
+}
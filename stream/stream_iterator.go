@@ -1,6 +1,7 @@
 package stream
 
 import (
+	"fmt"
 	"sync"
 	"sync/atomic"
 
@@ -11,6 +12,34 @@ import (
 type iteratorStream[T any] struct {
 	iterator iterator.Iterator[T]
 	parallel bool
+	stages   []*stage
+	tracer   *tracer
+	usage    sync.Mutex
+	usedBy   string
+	consumed bool
+}
+
+// claim marks the stream as used by the operation `op`, either linking a new
+// stage or consuming the stream if `terminal` is true.
+//
+// claim panics if the stream was already linked or consumed.
+func (s *iteratorStream[T]) claim(op string, terminal bool) {
+	s.usage.Lock()
+	defer s.usage.Unlock()
+	s.checkUnused(op)
+	s.usedBy = op
+	s.consumed = terminal
+}
+
+// checkUnused panics if the stream was already linked or consumed when
+// applying the operation `op`. The caller must hold the usage lock.
+func (s *iteratorStream[T]) checkUnused(op string) {
+	if s.usedBy != "" {
+		if s.consumed {
+			panic(fmt.Sprintf("stream: cannot apply %s, the stream was already consumed by %s", op, s.usedBy))
+		}
+		panic(fmt.Sprintf("stream: cannot apply %s, the stream was already linked to %s; use Tee to fan out a stream", op, s.usedBy))
+	}
 }
 
 // Skip
@@ -46,14 +75,11 @@ func (s *skip[T]) Next() fluent.Option[T] {
 }
 
 func (s *iteratorStream[T]) Skip(count int) Stream[T] {
-	return &iteratorStream[T]{
-		parallel: s.parallel,
-		iterator: &skip[T]{
-			count:   count,
-			skipped: false,
-			source:  s.iterator,
-		},
-	}
+	return s.then(fmt.Sprintf("Skip(%d)", count), &skip[T]{
+		count:   count,
+		skipped: false,
+		source:  s.iterator,
+	})
 }
 
 // Limit
@@ -61,6 +87,7 @@ func (s *iteratorStream[T]) Skip(count int) Stream[T] {
 type limit[T any] struct {
 	max     int
 	current int
+	release func()
 	source  iterator.Iterator[T]
 }
 
@@ -78,25 +105,25 @@ func (l *limit[T]) Next() fluent.Option[T] {
 		l.current++
 		return l.source.Next()
 	} else {
+		l.release()
 		return fluent.Empty[T]()
 	}
 }
 
 func (s *iteratorStream[T]) Limit(max int) Stream[T] {
-	return &iteratorStream[T]{
-		parallel: s.parallel,
-		iterator: &limit[T]{
-			max:     max,
-			current: 0,
-			source:  s.iterator,
-		},
-	}
+	return s.then(fmt.Sprintf("Limit(%d)", max), &limit[T]{
+		max:     max,
+		current: 0,
+		release: s.release,
+		source:  s.iterator,
+	})
 }
 
 // While
 
 type while[T any] struct {
 	condition func(T) bool
+	release   func()
 	source    iterator.Iterator[T]
 }
 
@@ -109,17 +136,16 @@ func (w *while[T]) Next() fluent.Option[T] {
 	if next.IsPresent() && w.condition(next.Get()) {
 		return next
 	}
+	w.release()
 	return fluent.Empty[T]()
 }
 
 func (s *iteratorStream[T]) While(condition func(T) bool) Stream[T] {
-	return &iteratorStream[T]{
-		parallel: s.parallel,
-		iterator: &while[T]{
-			condition: condition,
-			source:    s.iterator,
-		},
-	}
+	return s.then("While", &while[T]{
+		condition: condition,
+		release:   s.release,
+		source:    s.iterator,
+	})
 }
 
 // Filter
@@ -138,13 +164,10 @@ func (f filter[T]) Next() fluent.Option[T] {
 }
 
 func (s *iteratorStream[T]) Filter(fn func(T) bool) Stream[T] {
-	return &iteratorStream[T]{
-		parallel: s.parallel,
-		iterator: filter[T]{
-			filter: fn,
-			source: s.iterator,
-		},
-	}
+	return s.then("Filter", filter[T]{
+		filter: fn,
+		source: s.iterator,
+	})
 }
 
 // Map
@@ -163,13 +186,10 @@ func (m mapper[T]) Next() fluent.Option[T] {
 }
 
 func (s *iteratorStream[T]) Map(fn func(T) T) Stream[T] {
-	return &iteratorStream[T]{
-		parallel: s.parallel,
-		iterator: mapper[T]{
-			mapper: fn,
-			source: s.iterator,
-		},
-	}
+	return s.then("Map", mapper[T]{
+		mapper: fn,
+		source: s.iterator,
+	})
 }
 
 // Peek
@@ -190,13 +210,29 @@ func (p peek[T]) Next() fluent.Option[T] {
 }
 
 func (s *iteratorStream[T]) Peek(consumer func(T)) Stream[T] {
-	return &iteratorStream[T]{
-		parallel: s.parallel,
-		iterator: peek[T]{
-			consumer: consumer,
-			source:   s.iterator,
-		},
+	return s.then("Peek", peek[T]{
+		consumer: consumer,
+		source:   s.iterator,
+	})
+}
+
+// Tee
+
+func (s *iteratorStream[T]) Tee(n int) []Stream[T] {
+	if n <= 0 {
+		panic("tee count must be positive")
 	}
+	s.claim(fmt.Sprintf("Tee(%d)", n), false)
+	iterators := iterator.Tee(s.iterator, n)
+	branches := make([]Stream[T], n)
+	remaining := int32(n)
+	for i := range branches {
+		branch := extend(s, fmt.Sprintf("Tee(%d/%d)", i+1, n), iterators[i])
+		branch.stages[len(branch.stages)-1].branches = &remaining
+		branch.parallel = s.parallel
+		branches[i] = branch
+	}
+	return branches
 }
 
 type concurrent[T any] struct {
@@ -216,17 +252,22 @@ func (c *concurrent[T]) Size() fluent.Option[int] {
 }
 
 func (s *iteratorStream[T]) Parallel() Stream[T] {
-	return &iteratorStream[T]{
-		parallel: true,
-		iterator: &concurrent[T]{
-			source: s.iterator,
-		},
-	}
+	parallel := s.then("Parallel", &concurrent[T]{
+		source: s.iterator,
+	})
+	parallel.parallel = true
+	return parallel
 }
 
 // For Each
 
 func (s *iteratorStream[T]) ForEach(fn func(int, T)) {
+	s.claim("ForEach", true)
+	defer s.release()
+	s.forEach(fn)
+}
+
+func (s *iteratorStream[T]) forEach(fn func(int, T)) {
 	it := s.iterator
 	if s.parallel {
 		size := iterator.Size(s.iterator)
@@ -286,8 +327,10 @@ func (s *iteratorStream[T]) sizedParallelForEach(fn func(int, T), size int) {
 // Count
 
 func (s *iteratorStream[T]) Count() int {
+	s.claim("Count", true)
+	defer s.release()
 	var counter int32
-	s.ForEach(func(_ int, _ T) {
+	s.forEach(func(_ int, _ T) {
 		atomic.AddInt32(&counter, 1)
 	})
 	return int(counter)
@@ -296,31 +339,34 @@ func (s *iteratorStream[T]) Count() int {
 // Iterator
 
 func (s *iteratorStream[T]) Iterator() iterator.Iterator[T] {
+	s.claim("Iterator", true)
 	return s.iterator
 }
 
 // Array
 func (s *iteratorStream[T]) Array() []T {
+	s.claim("Array", true)
+	defer s.release()
 	size := iterator.Size(s.iterator)
 
 	var arr []T
 
 	if size.IsPresent() {
 		arr = make([]T, size.Get())
-		s.ForEach(func(index int, val T) {
+		s.forEach(func(index int, val T) {
 			arr[index] = val
 		})
 	} else if s.parallel {
 		var mtx sync.Mutex
 		arr = make([]T, 0, 10)
-		s.ForEach(func(_ int, val T) {
+		s.forEach(func(_ int, val T) {
 			mtx.Lock()
 			arr = append(arr, val)
 			mtx.Unlock()
 		})
 	} else {
 		arr = make([]T, 0, 10)
-		s.ForEach(func(_ int, val T) {
+		s.forEach(func(_ int, val T) {
 			arr = append(arr, val)
 		})
 	}
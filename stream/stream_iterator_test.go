@@ -6,7 +6,6 @@ import (
 
 	"github.com/mikhasd/fluent"
 	"github.com/mikhasd/fluent/iterator"
-	"github.com/mikhasd/fluent/set"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -250,10 +249,7 @@ func Test_iteratorStream_Array(t *testing.T) {
 func Test_iteratorStream_Array_parallel(t *testing.T) {
 	arr := FromArray(streamTestData).Parallel().Array()
 
-	expected := set.FromArray(streamTestData)
-	actual := set.FromArray(arr)
-	assert.True(t, expected.ContainsAll(actual), "content")
-	assert.Equal(t, expected.Size(), actual.Size(), "size")
+	assert.ElementsMatch(t, streamTestData, arr, "content")
 }
 
 func Test_iteratorStream_Peek(t *testing.T) {
@@ -309,11 +305,8 @@ func Test_iteratorStream_ForEach_Parallel(t *testing.T) {
 
 	FromArray(streamTestData).Parallel().ForEach(counter)
 
-	originalSet := set.FromArray(streamTestData)
-	processedSet := set.FromArray(arr)
-
 	assert.Equal(t, int32(len(streamTestData)), count, "size")
-	assert.True(t, processedSet.ContainsAll(originalSet), "content")
+	assert.ElementsMatch(t, streamTestData, arr, "content")
 }
 
 func Test_iteratorStream_Array_Parallel_NotSized(t *testing.T) {
@@ -324,9 +317,90 @@ func Test_iteratorStream_Array_Parallel_NotSized(t *testing.T) {
 
 	arr := FromArray(streamTestData).Parallel().Filter(even).Array()
 
-	originalSet := set.FromArray(streamTestData)
-	processedSet := set.FromArray(arr)
-
 	assert.Equal(t, len(streamTestData)/2, len(arr), "size")
-	assert.True(t, originalSet.ContainsAll(processedSet), "content")
+	assert.ElementsMatch(t, []int{2, 4, 6, 8, 10}, arr, "content")
+}
+
+func Test_iteratorStream_reuse_consumed(t *testing.T) {
+	s := FromArray(streamTestData)
+	s.Array()
+
+	assert.PanicsWithValue(t,
+		"stream: cannot apply Array, the stream was already consumed by Array",
+		func() { s.Array() })
+	assert.Panics(t, func() { s.Count() }, "Count")
+	assert.Panics(t, func() { s.Iterator() }, "Iterator")
+	assert.Panics(t, func() { s.ForEach(func(int, int) {}) }, "ForEach")
+	assert.Panics(t, func() { s.Skip(1) }, "Skip")
+}
+
+func Test_iteratorStream_reuse_linked(t *testing.T) {
+	s := FromArray(streamTestData)
+	s.Skip(2)
+
+	assert.PanicsWithValue(t,
+		"stream: cannot apply Limit(2), the stream was already linked to Skip(2); use Tee to fan out a stream",
+		func() { s.Limit(2) })
+	assert.Panics(t, func() { s.Filter(func(int) bool { return true }) }, "Filter")
+	assert.Panics(t, func() { Map(s, func(int) int { return 0 }) }, "Map")
+	assert.Panics(t, func() { Chunk(s, 2) }, "Chunk")
+	assert.Panics(t, func() { s.Tee(2) }, "Tee")
+	assert.Panics(t, func() { s.Array() }, "Array")
+}
+
+func Test_iteratorStream_reuse_describe(t *testing.T) {
+	s := FromArray(streamTestData)
+	s.Count()
+
+	assert.NotPanics(t, func() {
+		s.Describe()
+		s.Stats()
+	})
+}
+
+func Test_iteratorStream_Tee(t *testing.T) {
+	streams := FromArray(streamTestData).Tee(3)
+	assert.Len(t, streams, 3)
+
+	first := streams[0].Iterator()
+	first.Next()
+	first.Next()
+
+	assert.Equal(t, streamTestData, streams[1].Array(), "second")
+	assert.Equal(t, len(streamTestData), streams[2].Count(), "third")
+
+	var rest []int
+	for o := first.Next(); o.IsPresent(); o = first.Next() {
+		rest = append(rest, o.Get())
+	}
+	assert.Equal(t, streamTestData[2:], rest, "first")
+}
+
+func Test_iteratorStream_Tee_parallel(t *testing.T) {
+	streams := FromArray(streamTestData).Parallel().Tee(2)
+	results := make(chan []int, len(streams))
+	for _, s := range streams {
+		go func(s Stream[int]) {
+			results <- s.Array()
+		}(s)
+	}
+
+	for range streams {
+		arr := <-results
+		assert.ElementsMatch(t, streamTestData, arr)
+	}
+}
+
+func Test_iteratorStream_Tee_invalid(t *testing.T) {
+	assert.Panics(t, func() {
+		FromArray(streamTestData).Tee(0)
+	})
+}
+
+func Test_iteratorStream_Tee_Size(t *testing.T) {
+	streams := FromArray(streamTestData).Tee(2)
+	size := iterator.Size(streams[0].Iterator())
+
+	assert.True(t, size.IsPresent(), "present")
+	assert.Equal(t, len(streamTestData), size.Get(), "size")
 }
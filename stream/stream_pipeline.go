@@ -0,0 +1,259 @@
+package stream
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+)
+
+// TraceEvent describes an element emitted by one of the stages of a stream
+// pipeline.
+type TraceEvent struct {
+	// Stage is the description of the stage emitting the element.
+	Stage string
+	// Position is the index of the stage in the pipeline. The data source is at
+	// position 0.
+	Position int
+	// Element is the emitted element, or nil if Done is true.
+	Element any
+	// Done is true when the stage has no more elements to emit.
+	Done bool
+	// Elapsed is the time taken by the stage, including the stages before it,
+	// to produce the element.
+	Elapsed time.Duration
+}
+
+// StageStats holds the counters of a stage of a stream pipeline.
+type StageStats struct {
+	// Stage is the description of the stage.
+	Stage string
+	// Size is the number of elements the stage is expected to emit, if known.
+	Size fluent.Option[int]
+	// In is the number of elements received by the stage.
+	In int
+	// Out is the number of elements emitted by the stage.
+	Out int
+}
+
+type stage struct {
+	out      int64
+	name     string
+	position int
+	size     func() fluent.Option[int]
+	// branches counts the branches of a Tee not released yet, shared by the
+	// stages reading them, or is nil if the stage does not read a Tee branch.
+	branches *int32
+	released *signal
+}
+
+// signal is a channel closed at most once, to notify every goroutine waiting
+// on it.
+type signal struct {
+	ch   chan struct{}
+	once sync.Once
+}
+
+func newSignal() *signal {
+	return &signal{ch: make(chan struct{})}
+}
+
+// close closes the signal, returning false if it was already closed.
+func (s *signal) close() bool {
+	closed := false
+	s.once.Do(func() {
+		close(s.ch)
+		closed = true
+	})
+	return closed
+}
+
+func (s *signal) done() <-chan struct{} {
+	return s.ch
+}
+
+// tracer holds the trace function of a pipeline, shared by its stages and by
+// the branches of a Tee, which may be read while another branch registers it.
+type tracer struct {
+	fn atomic.Value
+}
+
+func (t *tracer) load() func(TraceEvent) {
+	fn, _ := t.fn.Load().(func(TraceEvent))
+	return fn
+}
+
+// Traced
+
+type traced[T any] struct {
+	stage  *stage
+	tracer *tracer
+	source iterator.Iterator[T]
+}
+
+func (t traced[T]) Next() fluent.Option[T] {
+	fn := t.tracer.load()
+	if fn == nil {
+		o := t.source.Next()
+		if o.IsPresent() {
+			atomic.AddInt64(&t.stage.out, 1)
+		}
+		return o
+	}
+
+	start := time.Now()
+	o := t.source.Next()
+	event := TraceEvent{
+		Stage:    t.stage.name,
+		Position: t.stage.position,
+		Done:     !o.IsPresent(),
+		Elapsed:  time.Since(start),
+	}
+	if o.IsPresent() {
+		atomic.AddInt64(&t.stage.out, 1)
+		event.Element = o.Get()
+	}
+	fn(event)
+	return o
+}
+
+// Implements iterator.Sized interface
+func (t traced[T]) Size() fluent.Option[int] {
+	return iterator.Size(t.source)
+}
+
+// source creates a stream pipeline with the given iterator as its data source.
+func source[T any](name string, it iterator.Iterator[T]) *iteratorStream[T] {
+	st := &stage{
+		name: name,
+		size: func() fluent.Option[int] {
+			return iterator.Size(it)
+		},
+		released: newSignal(),
+	}
+	tr := &tracer{}
+	return &iteratorStream[T]{
+		iterator: traced[T]{
+			stage:  st,
+			tracer: tr,
+			source: it,
+		},
+		stages: []*stage{st},
+		tracer: tr,
+	}
+}
+
+// link appends to the pipeline of the stream `s` a new stage built on top of
+// the stream iterator. The resulting stream is sequential.
+//
+// link panics if the stream `s` was already linked or consumed.
+func link[T any, R any](s Stream[T], name string, build func(iterator.Iterator[T]) iterator.Iterator[R]) *iteratorStream[R] {
+	src, ok := s.(*iteratorStream[T])
+	if ok {
+		src.claim(name, false)
+	} else {
+		src = source("Stream", s.Iterator())
+	}
+	return extend(src, name, build(src.iterator))
+}
+
+// extend appends to the pipeline of the stream `src` a new stage emitting the
+// elements of the iterator `it`, without claiming the stream.
+func extend[T any, R any](src *iteratorStream[T], name string, it iterator.Iterator[R]) *iteratorStream[R] {
+	st := &stage{
+		name:     name,
+		position: len(src.stages),
+		size: func() fluent.Option[int] {
+			return iterator.Size(it)
+		},
+		released: newSignal(),
+	}
+	return &iteratorStream[R]{
+		iterator: traced[R]{
+			stage:  st,
+			tracer: src.tracer,
+			source: it,
+		},
+		stages: append(src.stages[:len(src.stages):len(src.stages)], st),
+		tracer: src.tracer,
+	}
+}
+
+// release signals the stages of the pipeline that their elements are no
+// longer requested. The stages before a Tee are only released with the last
+// of its branches.
+func (s *iteratorStream[T]) release() {
+	for i := len(s.stages) - 1; i >= 0; i-- {
+		st := s.stages[i]
+		if !st.released.close() {
+			return
+		}
+		if st.branches != nil && atomic.AddInt32(st.branches, -1) > 0 {
+			return
+		}
+	}
+}
+
+// released returns the signal closed once the elements of the stream are no
+// longer requested.
+func (s *iteratorStream[T]) released() *signal {
+	return s.stages[len(s.stages)-1].released
+}
+
+func (s *iteratorStream[T]) then(name string, it iterator.Iterator[T]) *iteratorStream[T] {
+	next := link[T, T](s, name, func(iterator.Iterator[T]) iterator.Iterator[T] {
+		return it
+	})
+	next.parallel = s.parallel
+	return next
+}
+
+// Stats
+
+func (s *iteratorStream[T]) Stats() []StageStats {
+	stats := make([]StageStats, len(s.stages))
+	for i, st := range s.stages {
+		out := int(atomic.LoadInt64(&st.out))
+		in := out
+		if i > 0 {
+			in = stats[i-1].Out
+		}
+		stats[i] = StageStats{
+			Stage: st.name,
+			Size:  st.size(),
+			In:    in,
+			Out:   out,
+		}
+	}
+	return stats
+}
+
+// Describe
+
+func (s *iteratorStream[T]) Describe() string {
+	var b strings.Builder
+	for i, st := range s.Stats() {
+		if i > 0 {
+			b.WriteString("\n -> ")
+		}
+		size := fluent.MapOption(st.Size, func(size int) string {
+			return fmt.Sprintf("%d", size)
+		}).OrElse("?")
+		fmt.Fprintf(&b, "%s [size=%s, in=%d, out=%d]", st.Stage, size, st.In, st.Out)
+	}
+	return b.String()
+}
+
+// Trace
+
+func (s *iteratorStream[T]) Trace(fn func(TraceEvent)) Stream[T] {
+	s.usage.Lock()
+	defer s.usage.Unlock()
+	s.checkUnused("Trace")
+	s.tracer.fn.Store(fn)
+	return s
+}
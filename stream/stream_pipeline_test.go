@@ -0,0 +1,152 @@
+package stream
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_iteratorStream_Describe(t *testing.T) {
+	isEven := func(n int) bool {
+		return n%2 == 0
+	}
+	s := FromArray(streamTestData).Skip(2).Filter(isEven).Limit(3)
+
+	expected := "FromArray [size=10, in=0, out=0]" +
+		"\n -> Skip(2) [size=8, in=0, out=0]" +
+		"\n -> Filter [size=?, in=0, out=0]" +
+		"\n -> Limit(3) [size=?, in=0, out=0]"
+	assert.Equal(t, expected, s.Describe())
+}
+
+func Test_iteratorStream_Describe_consumed(t *testing.T) {
+	isEven := func(n int) bool {
+		return n%2 == 0
+	}
+	s := Chunk(FromArray(streamTestData).Filter(isEven), 2)
+	s.Count()
+
+	expected := "FromArray [size=10, in=10, out=10]" +
+		"\n -> Filter [size=?, in=10, out=5]" +
+		"\n -> Chunk(2) [size=?, in=5, out=3]"
+	assert.Equal(t, expected, s.Describe())
+}
+
+func Test_iteratorStream_Stats(t *testing.T) {
+	s := Map(FromArray(streamTestData).Limit(4), func(i int) string {
+		return "value"
+	})
+	s.Array()
+
+	expected := []StageStats{
+		{Stage: "FromArray", Size: fluent.Present(10), In: 4, Out: 4},
+		{Stage: "Limit(4)", Size: fluent.Present(4), In: 4, Out: 4},
+		{Stage: "Map", Size: fluent.Empty[int](), In: 4, Out: 4},
+	}
+	assert.Equal(t, expected, s.Stats())
+}
+
+func Test_iteratorStream_Stats_parallel(t *testing.T) {
+	s := FromArray(streamTestData).Parallel().Map(func(i int) int {
+		return i * 2
+	})
+	s.Count()
+
+	stats := s.Stats()
+	assert.Len(t, stats, 3, "stages")
+	for _, st := range stats {
+		assert.Equal(t, len(streamTestData), st.Out, st.Stage)
+	}
+}
+
+func Test_iteratorStream_Trace(t *testing.T) {
+	var events []TraceEvent
+	s := FromArray([]int{1, 2, 3}).Filter(func(i int) bool {
+		return i != 2
+	}).Trace(func(e TraceEvent) {
+		events = append(events, e)
+	})
+	s.Array()
+
+	type event struct {
+		position int
+		element  any
+		done     bool
+	}
+	var actual []event
+	for _, e := range events {
+		actual = append(actual, event{e.Position, e.Element, e.Done})
+		assert.True(t, e.Elapsed >= 0, "elapsed")
+	}
+
+	expected := []event{
+		{0, 1, false}, {1, 1, false},
+		{0, 2, false},
+		{0, 3, false}, {1, 3, false},
+		{0, nil, true}, {1, nil, true},
+	}
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, "FromArray", events[0].Stage)
+	assert.Equal(t, "Filter", events[1].Stage)
+}
+
+func Test_iteratorStream_Trace_claimed(t *testing.T) {
+	s := FromArray([]int{1, 2, 3})
+	parallel := s.Parallel()
+	noop := func(TraceEvent) {}
+
+	assert.PanicsWithValue(t, "stream: cannot apply Trace, the stream was already linked to Parallel; use Tee to fan out a stream", func() {
+		s.Trace(noop)
+	})
+	parallel.Count()
+	assert.PanicsWithValue(t, "stream: cannot apply Trace, the stream was already consumed by Count", func() {
+		parallel.Trace(noop)
+	})
+}
+
+func Test_iteratorStream_Trace_parallel(t *testing.T) {
+	var count int64
+	FromArray(streamTestData).Parallel().Trace(func(e TraceEvent) {
+		atomic.AddInt64(&count, 1)
+	}).Count()
+
+	assert.Equal(t, int64(2*len(streamTestData)), atomic.LoadInt64(&count))
+}
+
+func Test_iteratorStream_Trace_tee(t *testing.T) {
+	branches := FromArray(make([]int, 20)).Tee(2)
+	started, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		var once sync.Once
+		branches[0].Peek(func(int) {
+			once.Do(func() { close(started) })
+			time.Sleep(time.Millisecond)
+		}).Count()
+	}()
+
+	<-started
+	branches[1].Trace(func(TraceEvent) {}).Count()
+	<-done
+}
+
+func Test_link_foreignStream(t *testing.T) {
+	var foreign Stream[int] = foreignStream{FromArray(streamTestData)}
+	s := Chunk(foreign, 5)
+
+	assert.Equal(t, 2, s.Count())
+	assert.Equal(t, "Stream [size=10, in=10, out=10]\n -> Chunk(5) [size=2, in=10, out=2]", s.Describe())
+}
+
+type foreignStream struct {
+	Stream[int]
+}
+
+func (f foreignStream) Iterator() iterator.Iterator[int] {
+	return iterator.FromArray(streamTestData)
+}
@@ -3,7 +3,6 @@ package stream
 import (
 	"testing"
 
-	"github.com/mikhasd/fluent/set"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -27,12 +26,41 @@ func Test_MapArray(t *testing.T) {
 		return int(val)%2 == 0
 	}
 
-	computed := set.FromIterable[float32](
-		MapArray(arr, toFloat).Filter(even),
-	)
+	computed := MapArray(arr, toFloat).Filter(even).Array()
 
-	expected := set.FromArray([]float32{2, 4, 6, 8, 10})
+	expected := []float32{2, 4, 6, 8, 10}
 
-	assert.True(t, computed.ContainsAll(expected), "content")
-	assert.Equal(t, expected.Size(), computed.Size(), "size")
+	assert.ElementsMatch(t, expected, computed, "content")
+}
+
+func Test_Chunk(t *testing.T) {
+	s := Chunk(Of(1, 2, 3, 4, 5), 2)
+	expected := [][]int{{1, 2}, {3, 4}, {5}}
+
+	actual := s.Array()
+	assert.Equal(t, expected, actual)
+}
+
+func Test_Chunk_afterSkip(t *testing.T) {
+	s := Chunk(FromArray(streamTestData).Skip(4), 4)
+
+	assert.Equal(t, 2, s.Count())
+}
+
+func Test_Window(t *testing.T) {
+	s := Window(Of(1, 2, 3, 4, 5), 3, 1)
+	expected := [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+
+	actual := s.Array()
+	assert.Equal(t, expected, actual)
+}
+
+func Test_ChunkBy(t *testing.T) {
+	s := ChunkBy(Of(1, 3, 2, 4, 6, 5), func(i int) bool {
+		return i%2 == 0
+	})
+	expected := [][]int{{1, 3}, {2, 4, 6}, {5}}
+
+	actual := s.Array()
+	assert.Equal(t, expected, actual)
 }
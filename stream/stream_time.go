@@ -0,0 +1,328 @@
+package stream
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+)
+
+// The time based operations in this file accept a Clock. If the provided
+// clock is nil, the system clock is used.
+//
+// Operations which must react to time passing while waiting for the next
+// element (BatchTimed, Debounce and Sample) consume the source stream from a
+// dedicated goroutine, started when the first element is requested. The
+// goroutine stops once the source is exhausted, or once the elements of the
+// stage are no longer requested: when the terminal operation returns, when a
+// later Limit or While stage stops reading, or when an iterator of the stream
+// dropped before its end is garbage collected. A source blocked waiting for
+// its next element, such as an open channel, keeps the goroutine running
+// until that element arrives.
+
+// feed consumes the iterator from a dedicated goroutine, sending its elements
+// to the returned channel until the iterator is exhausted or `stop` is
+// closed.
+func feed[T any](it iterator.Iterator[T], stop <-chan struct{}) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for o := it.Next(); o.IsPresent(); o = it.Next() {
+			select {
+			case ch <- o.Get():
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// Batch Timed
+
+type batchTimed[T any] struct {
+	size     int
+	timeout  time.Duration
+	clock    Clock
+	source   iterator.Iterator[T]
+	released *signal
+	elems    <-chan T
+	done     bool
+}
+
+func (b *batchTimed[T]) Next() fluent.Option[[]T] {
+	if b.done {
+		return fluent.Empty[[]T]()
+	}
+	if b.elems == nil {
+		b.elems = feed(b.source, b.released.done())
+	}
+	var batch []T
+	var timeout <-chan time.Time
+	for {
+		select {
+		case value, ok := <-b.elems:
+			if !ok {
+				b.done = true
+				if len(batch) == 0 {
+					return fluent.Empty[[]T]()
+				}
+				return fluent.Present(batch)
+			}
+			if batch == nil {
+				batch = make([]T, 0, b.size)
+				timeout = b.clock.After(b.timeout)
+			}
+			batch = append(batch, value)
+			if len(batch) == b.size {
+				return fluent.Present(batch)
+			}
+		case <-timeout:
+			return fluent.Present(batch)
+		}
+	}
+}
+
+// BatchTimed returns a stream of arrays grouping the elements of the source
+// stream. A batch is emitted once it holds `size` elements or once `timeout`
+// has elapsed since its first element was received, whichever comes first.
+//
+// BatchTimed panics if `size` is not positive.
+func BatchTimed[T any](s Stream[T], size int, timeout time.Duration, clock Clock) Stream[[]T] {
+	if size <= 0 {
+		panic("batch size must be positive")
+	}
+	name := fmt.Sprintf("BatchTimed(%d, %s)", size, timeout)
+	b := &batchTimed[T]{
+		size:    size,
+		timeout: timeout,
+		clock:   clockOrSystem(clock),
+	}
+	next := link(s, name, func(it iterator.Iterator[T]) iterator.Iterator[[]T] {
+		b.source = it
+		return b
+	})
+	b.released = next.released()
+	releaseOnCollect(b, b.released)
+	return next
+}
+
+// Throttle
+
+type throttle[T any] struct {
+	interval time.Duration
+	clock    Clock
+	next     time.Time
+	source   iterator.Iterator[T]
+}
+
+func (t *throttle[T]) Next() fluent.Option[T] {
+	for o := t.source.Next(); o.IsPresent(); o = t.source.Next() {
+		now := t.clock.Now()
+		if !now.Before(t.next) {
+			t.next = now.Add(t.interval)
+			return o
+		}
+	}
+	return fluent.Empty[T]()
+}
+
+// Throttle returns a stream that emits an element of the source stream and
+// then discards every element received during the following `interval`.
+func Throttle[T any](s Stream[T], interval time.Duration, clock Clock) Stream[T] {
+	name := fmt.Sprintf("Throttle(%s)", interval)
+	return link(s, name, func(it iterator.Iterator[T]) iterator.Iterator[T] {
+		return &throttle[T]{
+			interval: interval,
+			clock:    clockOrSystem(clock),
+			source:   it,
+		}
+	})
+}
+
+// Rate Limit
+
+type rateLimit[T any] struct {
+	interval time.Duration
+	burst    float64
+	tokens   float64
+	last     time.Time
+	started  bool
+	clock    Clock
+	source   iterator.Iterator[T]
+}
+
+func (r *rateLimit[T]) refill() {
+	now := r.clock.Now()
+	if !r.started {
+		r.started = true
+		r.tokens = r.burst
+	} else {
+		r.tokens += float64(now.Sub(r.last)) / float64(r.interval)
+		if r.tokens > r.burst {
+			r.tokens = r.burst
+		}
+	}
+	r.last = now
+}
+
+func (r *rateLimit[T]) Next() fluent.Option[T] {
+	o := r.source.Next()
+	if !o.IsPresent() {
+		return o
+	}
+	r.refill()
+	if r.tokens < 1 {
+		r.clock.Sleep(time.Duration((1 - r.tokens) * float64(r.interval)))
+		r.refill()
+		if r.tokens < 1 {
+			r.tokens = 1
+		}
+	}
+	r.tokens--
+	return o
+}
+
+// Implements iterator.Sized interface
+func (r *rateLimit[T]) Size() fluent.Option[int] {
+	return iterator.Size(r.source)
+}
+
+// RateLimit returns a stream that delays the elements of the source stream so
+// no more than `limit` elements are emitted per `per` duration.
+//
+// The limit is enforced with a token bucket holding up to `burst` tokens,
+// allowing up to `burst` elements to be emitted without delay after a period
+// of inactivity.
+//
+// RateLimit panics if `limit` or `burst` are not positive.
+func RateLimit[T any](s Stream[T], limit int, per time.Duration, burst int, clock Clock) Stream[T] {
+	if limit <= 0 {
+		panic("rate limit must be positive")
+	}
+	if burst <= 0 {
+		panic("rate limit burst must be positive")
+	}
+	name := fmt.Sprintf("RateLimit(%d/%s, %d)", limit, per, burst)
+	return link(s, name, func(it iterator.Iterator[T]) iterator.Iterator[T] {
+		return &rateLimit[T]{
+			interval: per / time.Duration(limit),
+			burst:    float64(burst),
+			clock:    clockOrSystem(clock),
+			source:   it,
+		}
+	})
+}
+
+// Debounce
+
+type debounce[T any] struct {
+	quiet    time.Duration
+	clock    Clock
+	source   iterator.Iterator[T]
+	released *signal
+	elems    <-chan T
+	done     bool
+}
+
+func (d *debounce[T]) Next() fluent.Option[T] {
+	if d.done {
+		return fluent.Empty[T]()
+	}
+	if d.elems == nil {
+		d.elems = feed(d.source, d.released.done())
+	}
+	pending := fluent.Empty[T]()
+	var timeout <-chan time.Time
+	for {
+		select {
+		case value, ok := <-d.elems:
+			if !ok {
+				d.done = true
+				return pending
+			}
+			pending = fluent.Present(value)
+			timeout = d.clock.After(d.quiet)
+		case <-timeout:
+			return pending
+		}
+	}
+}
+
+// Debounce returns a stream that emits an element of the source stream only
+// once `quiet` has elapsed without another element being received. The last
+// element is emitted when the source stream finishes.
+func Debounce[T any](s Stream[T], quiet time.Duration, clock Clock) Stream[T] {
+	name := fmt.Sprintf("Debounce(%s)", quiet)
+	d := &debounce[T]{
+		quiet: quiet,
+		clock: clockOrSystem(clock),
+	}
+	next := link(s, name, func(it iterator.Iterator[T]) iterator.Iterator[T] {
+		d.source = it
+		return d
+	})
+	d.released = next.released()
+	releaseOnCollect(d, d.released)
+	return next
+}
+
+// Sample
+
+type sample[T any] struct {
+	interval time.Duration
+	clock    Clock
+	source   iterator.Iterator[T]
+	released *signal
+	elems    <-chan T
+	tick     <-chan time.Time
+	latest   fluent.Option[T]
+	done     bool
+}
+
+func (s *sample[T]) Next() fluent.Option[T] {
+	if s.done {
+		return fluent.Empty[T]()
+	}
+	if s.elems == nil {
+		s.latest = fluent.Empty[T]()
+		s.tick = s.clock.After(s.interval)
+		s.elems = feed(s.source, s.released.done())
+	}
+	for {
+		select {
+		case value, ok := <-s.elems:
+			if !ok {
+				s.done = true
+				return s.latest
+			}
+			s.latest = fluent.Present(value)
+		case <-s.tick:
+			s.tick = s.clock.After(s.interval)
+			if latest := s.latest; latest.IsPresent() {
+				s.latest = fluent.Empty[T]()
+				return latest
+			}
+		}
+	}
+}
+
+// Sample returns a stream that emits, once every `interval`, the most recent
+// element received from the source stream, if any was received since the
+// previous emission. A pending element is emitted when the source stream
+// finishes.
+func Sample[T any](s Stream[T], interval time.Duration, clock Clock) Stream[T] {
+	name := fmt.Sprintf("Sample(%s)", interval)
+	sm := &sample[T]{
+		interval: interval,
+		clock:    clockOrSystem(clock),
+	}
+	next := link(s, name, func(it iterator.Iterator[T]) iterator.Iterator[T] {
+		sm.source = it
+		return sm
+	})
+	sm.released = next.released()
+	releaseOnCollect(sm, sm.released)
+	return next
+}
@@ -0,0 +1,15 @@
+//go:build go1.24
+
+package stream
+
+import "runtime"
+
+// releaseOnCollect closes `released` once `stage` is garbage collected, which
+// happens when its consumer drops an iterator of the stream before its end.
+// The feeding goroutine only references the source, so it does not keep the
+// stage reachable.
+func releaseOnCollect[S any](stage *S, released *signal) {
+	runtime.AddCleanup(stage, func(released *signal) {
+		released.close()
+	}, released)
+}
@@ -0,0 +1,242 @@
+package stream
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_BatchTimed(t *testing.T) {
+	clock := newFakeClock()
+	source := newSteppedSource()
+	out := drain(BatchTimed(FromIterable[int](source), 3, 10*time.Second, clock).Iterator())
+
+	source.Start()
+	source.Push(1)
+	clock.WaitForTimers(1)
+	clock.Advance(10 * time.Second)
+	assert.Equal(t, []int{1}, <-out, "timed batch")
+
+	source.Push(2)
+	source.Push(3)
+	source.Push(4)
+	assert.Equal(t, []int{2, 3, 4}, <-out, "full batch")
+
+	source.Push(5)
+	source.Close()
+	assert.Equal(t, []int{5}, <-out, "last batch")
+
+	_, open := <-out
+	assert.False(t, open, "finished")
+}
+
+func Test_BatchTimed_empty(t *testing.T) {
+	s := BatchTimed(FromArray([]int{}), 3, time.Second, newFakeClock())
+
+	assert.Empty(t, s.Array())
+}
+
+func Test_BatchTimed_invalid(t *testing.T) {
+	assert.Panics(t, func() {
+		BatchTimed(Of(1), 0, time.Second, nil)
+	})
+}
+
+func Test_Throttle(t *testing.T) {
+	clock := newFakeClock()
+	first := true
+	data := iterator.FromArray(streamTestData)
+	// one element every 4 seconds
+	source := iterator.Func(func() fluent.Option[int] {
+		if !first {
+			clock.Advance(4 * time.Second)
+		}
+		first = false
+		return data.Next()
+	})
+
+	actual := Throttle(FromIterator(source), 10*time.Second, clock).Array()
+
+	assert.Equal(t, []int{1, 4, 7, 10}, actual)
+}
+
+func Test_RateLimit(t *testing.T) {
+	clock := newFakeClock()
+	start := clock.Now()
+	var emitted []time.Duration
+
+	RateLimit(FromArray(streamTestData).Limit(5), 1, time.Second, 2, clock).
+		ForEach(func(_ int, _ int) {
+			emitted = append(emitted, clock.Now().Sub(start))
+		})
+
+	expected := []time.Duration{0, 0, time.Second, 2 * time.Second, 3 * time.Second}
+	assert.Equal(t, expected, emitted)
+}
+
+func Test_RateLimit_refill(t *testing.T) {
+	clock := newFakeClock()
+	var emitted []time.Time
+
+	it := RateLimit(FromArray(streamTestData), 2, time.Second, 1, clock).Iterator()
+	it.Next()
+	emitted = append(emitted, clock.Now())
+	clock.Advance(10 * time.Second)
+	it.Next()
+	emitted = append(emitted, clock.Now())
+	it.Next()
+	emitted = append(emitted, clock.Now())
+
+	assert.Equal(t, 10*time.Second, emitted[1].Sub(emitted[0]), "burst capped")
+	assert.Equal(t, 500*time.Millisecond, emitted[2].Sub(emitted[1]), "interval")
+}
+
+func Test_rateLimit_Size(t *testing.T) {
+	s := RateLimit(FromArray(streamTestData), 1, time.Second, 1, nil)
+	size := iterator.Size(s.Iterator())
+
+	assert.True(t, size.IsPresent(), "present")
+	assert.Equal(t, len(streamTestData), size.Get(), "size")
+}
+
+func Test_RateLimit_invalid(t *testing.T) {
+	assert.Panics(t, func() {
+		RateLimit(Of(1), 0, time.Second, 1, nil)
+	})
+	assert.Panics(t, func() {
+		RateLimit(Of(1), 1, time.Second, 0, nil)
+	})
+}
+
+func Test_Debounce(t *testing.T) {
+	clock := newFakeClock()
+	source := newSteppedSource()
+	out := drain(Debounce(FromIterable[int](source), 10*time.Second, clock).Iterator())
+
+	source.Start()
+	source.Push(1)
+	clock.WaitForTimers(1)
+	clock.Advance(5 * time.Second)
+	source.Push(2)
+	clock.WaitForTimers(2)
+	clock.Advance(5 * time.Second)
+	clock.Advance(5 * time.Second)
+	assert.Equal(t, 2, <-out, "quiet element")
+
+	source.Push(3)
+	clock.WaitForTimers(3)
+	clock.Advance(10 * time.Second)
+	assert.Equal(t, 3, <-out, "quiet element")
+
+	source.Push(4)
+	source.Close()
+	assert.Equal(t, 4, <-out, "last element")
+
+	_, open := <-out
+	assert.False(t, open, "finished")
+}
+
+func Test_Sample(t *testing.T) {
+	clock := newFakeClock()
+	source := newSteppedSource()
+	out := drain(Sample(FromIterable[int](source), 10*time.Second, clock).Iterator())
+
+	source.Start()
+	clock.WaitForTimers(1)
+	source.Push(1)
+	source.Push(2)
+	clock.Advance(10 * time.Second)
+	assert.Equal(t, 2, <-out, "sampled element")
+
+	clock.WaitForTimers(2)
+	clock.Advance(10 * time.Second)
+	clock.WaitForTimers(3)
+	source.Push(3)
+	source.Close()
+	assert.Equal(t, 3, <-out, "last element")
+
+	_, open := <-out
+	assert.False(t, open, "finished")
+}
+
+func Test_FromChannel(t *testing.T) {
+	ch := make(chan int, len(streamTestData))
+	for _, val := range streamTestData {
+		ch <- val
+	}
+	close(ch)
+
+	assert.Equal(t, streamTestData, FromChannel(ch).Array())
+}
+
+type endlessSource struct {
+	next int
+}
+
+func (e *endlessSource) Next() fluent.Option[int] {
+	e.next++
+	return fluent.Present(e.next)
+}
+
+func Test_feed_stop(t *testing.T) {
+	stop := make(chan struct{})
+	ch := feed[int](&endlessSource{}, stop)
+	<-ch
+	close(stop)
+
+	for range ch {
+	}
+}
+
+func released[T any](s Stream[T]) bool {
+	select {
+	case <-s.(*iteratorStream[T]).released().done():
+		return true
+	default:
+		return false
+	}
+}
+
+func Test_BatchTimed_released(t *testing.T) {
+	batches := BatchTimed(FromArray(make([]int, 1000)), 10, time.Second, nil)
+	assert.False(t, released(batches), "before")
+
+	batches.Limit(1).Array()
+	assert.True(t, released(batches), "after Limit")
+}
+
+func Test_Debounce_released(t *testing.T) {
+	debounced := Debounce(FromArray(make([]int, 1000)), time.Nanosecond, nil)
+
+	debounced.While(func(int) bool { return false }).Array()
+	assert.True(t, released(debounced), "after While")
+}
+
+func Test_Sample_released(t *testing.T) {
+	sampled := Sample(FromArray(make([]int, 1000)), time.Millisecond, nil)
+	it := sampled.Iterator()
+
+	it.Next()
+	assert.False(t, released(sampled), "iterating")
+}
+
+func Test_Sample_released_terminal(t *testing.T) {
+	sampled := Sample(FromArray(make([]int, 1000)), time.Millisecond, nil)
+
+	sampled.Count()
+	assert.True(t, released(sampled), "after Count")
+}
+
+func Test_Tee_released(t *testing.T) {
+	s := FromArray(make([]int, 10))
+	branches := s.Tee(2)
+
+	branches[0].Limit(1).Array()
+	assert.True(t, released(branches[0]), "branch")
+	assert.False(t, released(s), "shared stages")
+	branches[1].Limit(1).Array()
+	assert.True(t, released(s), "after the last branch")
+}
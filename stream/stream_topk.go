@@ -0,0 +1,82 @@
+package stream
+
+import (
+	"fmt"
+
+	"github.com/mikhasd/fluent"
+	"github.com/mikhasd/fluent/iterator"
+	"github.com/mikhasd/fluent/pq"
+)
+
+// TopK returns a stream of the `k` greatest elements of the source stream
+// according to the `less` function, from the greatest to the lowest.
+//
+// The source stream is consumed when the first element is requested, keeping
+// at most `k` elements in memory. TopK panics if `k` is negative.
+func TopK[T any](s Stream[T], k int, less func(a, b T) bool) Stream[T] {
+	if k < 0 {
+		panic("k must not be negative")
+	}
+	name := fmt.Sprintf("TopK(%d)", k)
+	return link(s, name, func(it iterator.Iterator[T]) iterator.Iterator[T] {
+		return &topKIterator[T]{
+			source: it,
+			k:      k,
+			less:   less,
+		}
+	})
+}
+
+type topKIterator[T any] struct {
+	source iterator.Iterator[T]
+	k      int
+	less   func(a, b T) bool
+	top    []T
+	index  int
+	done   bool
+}
+
+func (it *topKIterator[T]) Next() fluent.Option[T] {
+	if !it.done {
+		it.collect()
+	}
+	if it.index == len(it.top) {
+		return fluent.Empty[T]()
+	}
+	el := it.top[it.index]
+	it.index++
+	return fluent.Present(el)
+}
+
+// collect consumes the source keeping its `k` greatest elements in a queue
+// whose lowest element is the first evicted.
+func (it *topKIterator[T]) collect() {
+	it.done = true
+	queue := pq.New(it.less)
+	for o := it.source.Next(); o.IsPresent(); o = it.source.Next() {
+		el := o.Get()
+		if queue.Len() < it.k {
+			queue.Push(el)
+		} else if it.k > 0 && it.less(queue.Peek().Get(), el) {
+			queue.Pop()
+			queue.Push(el)
+		}
+	}
+	it.top = make([]T, queue.Len())
+	for i := len(it.top) - 1; i >= 0; i-- {
+		it.top[i] = queue.Pop().Get()
+	}
+}
+
+// Implements iterator.Sized interface
+func (it *topKIterator[T]) Size() fluent.Option[int] {
+	if it.done {
+		return fluent.Present(len(it.top))
+	}
+	return fluent.MapOption(iterator.Size(it.source), func(size int) int {
+		if size < it.k {
+			return size
+		}
+		return it.k
+	})
+}
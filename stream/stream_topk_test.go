@@ -0,0 +1,37 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TopK(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	s := TopK(Of(5, 1, 9, 3, 7, 9, 2), 3, less)
+
+	assert.Equal(t, []int{9, 9, 7}, s.Array())
+}
+
+func Test_TopK_fewerElements(t *testing.T) {
+	less := func(a, b string) bool { return a < b }
+	s := TopK(Of("b", "a"), 5, less)
+
+	assert.Equal(t, []string{"b", "a"}, s.Array())
+}
+
+func Test_TopK_zero(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	assert.Empty(t, TopK(Of(1, 2, 3), 0, less).Array())
+	assert.Panics(t, func() { TopK(Of(1), -1, less) })
+}
+
+func Test_TopK_Describe(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	s := TopK(Of(4, 2, 8, 6), 2, less)
+	s.Count()
+
+	expected := "FromArray [size=4, in=4, out=4]\n -> TopK(2) [size=2, in=4, out=2]"
+	assert.Equal(t, expected, s.Describe())
+}